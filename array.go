@@ -0,0 +1,98 @@
+package arrays
+
+import (
+	"fmt"
+	"reflect"
+)
+
+/*
+ToArray copies the provided slice into a fixed-size array of type A, after checking
+that the lengths match. The array type is given explicitly, for example
+ToArray[[16]byte](slice).
+
+Parameters:
+  - slice: The slice to convert.
+
+Returns:
+  - The array holding a copy of the slice.
+  - An error wrapping ErrLengthMismatch if the slice length differs from the array
+    length, or an error if A is not an array type with elements of type V.
+*/
+func ToArray[A any, V any](slice []V) (A, error) {
+	var array A
+
+	t, elem := reflect.TypeOf(array), reflect.TypeOf((*V)(nil)).Elem()
+	if t == nil || t.Kind() != reflect.Array || t.Elem() != elem {
+		return array, fmt.Errorf("arrays: %v is not an array of %v", t, elem)
+	}
+
+	if t.Len() != len(slice) {
+		return array, fmt.Errorf("%w: got %d elements, want %d", ErrLengthMismatch, len(slice), t.Len())
+	}
+
+	reflect.Copy(reflect.ValueOf(&array).Elem(), reflect.ValueOf(slice))
+
+	return array, nil
+}
+
+/*
+ToArray2 copies the provided slice into a [2]V after checking its length. See
+ToArray.
+*/
+func ToArray2[V any](slice []V) ([2]V, error) {
+	if len(slice) != 2 {
+		return [2]V{}, fmt.Errorf("%w: got %d elements, want 2", ErrLengthMismatch, len(slice))
+	}
+
+	return [2]V(slice), nil
+}
+
+/*
+ToArray3 copies the provided slice into a [3]V after checking its length. See
+ToArray.
+*/
+func ToArray3[V any](slice []V) ([3]V, error) {
+	if len(slice) != 3 {
+		return [3]V{}, fmt.Errorf("%w: got %d elements, want 3", ErrLengthMismatch, len(slice))
+	}
+
+	return [3]V(slice), nil
+}
+
+/*
+ToArray4 copies the provided slice into a [4]V after checking its length. See
+ToArray.
+*/
+func ToArray4[V any](slice []V) ([4]V, error) {
+	if len(slice) != 4 {
+		return [4]V{}, fmt.Errorf("%w: got %d elements, want 4", ErrLengthMismatch, len(slice))
+	}
+
+	return [4]V(slice), nil
+}
+
+/*
+FromArray copies the provided fixed-size array into a new slice. The element type
+is given explicitly, for example FromArray[byte](array), because it cannot be
+inferred from a generic array type.
+
+For a concrete array type, array[:] is simpler but shares memory with the array.
+
+Parameters:
+  - array: The array to convert.
+
+Returns:
+  - A new slice holding a copy of the array.
+  - An error if A is not an array type with elements of type V.
+*/
+func FromArray[V any, A any](array A) ([]V, error) {
+	t, elem := reflect.TypeOf(array), reflect.TypeOf((*V)(nil)).Elem()
+	if t == nil || t.Kind() != reflect.Array || t.Elem() != elem {
+		return nil, fmt.Errorf("arrays: %v is not an array of %v", t, elem)
+	}
+
+	result := make([]V, t.Len())
+	reflect.Copy(reflect.ValueOf(result), reflect.ValueOf(array))
+
+	return result, nil
+}
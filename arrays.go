@@ -46,6 +46,123 @@ func FindIndex[V any](predicate func(index int, value V) bool, slice []V) int {
 	return -1
 }
 
+/*
+FindMap applies the specified function to the elements of the provided slice in
+order, and returns the first value it maps successfully, finding and transforming
+an element in one pass.
+
+Parameters:
+  - fn: A function that takes an index and a value, and returns the mapped value
+    and true if the value is the one searched for.
+  - slice: The slice to search.
+
+Returns:
+  - The mapped value of the first element for which fn returns true, or the zero
+    value if there is none.
+  - Whether such an element was found.
+*/
+func FindMap[V, R any](fn func(index int, value V) (R, bool), slice []V) (R, bool) {
+	for i := 0; i < len(slice); i++ {
+		if r, ok := fn(i, slice[i]); ok {
+			return r, true
+		}
+	}
+
+	var zero R
+	return zero, false
+}
+
+/*
+Any reports whether the specified predicate function returns true for at least one
+element of the provided slice. It stops at the first such element.
+
+Parameters:
+  - predicate: A function that takes an index and a value, and returns true if the
+    value satisfies the desired condition.
+  - slice: The slice to check.
+
+Returns:
+  - True if some element satisfies the predicate; false for an empty slice.
+*/
+func Any[V any](predicate func(index int, value V) bool, slice []V) bool {
+	return FindIndex(predicate, slice) != -1
+}
+
+/*
+All reports whether the specified predicate function returns true for every element
+of the provided slice. It stops at the first element that fails it.
+
+Parameters:
+  - predicate: A function that takes an index and a value, and returns true if the
+    value satisfies the desired condition.
+  - slice: The slice to check.
+
+Returns:
+  - True if every element satisfies the predicate, including for an empty slice.
+*/
+func All[V any](predicate func(index int, value V) bool, slice []V) bool {
+	for i := 0; i < len(slice); i++ {
+		if !predicate(i, slice[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+/*
+None reports whether the specified predicate function returns false for every
+element of the provided slice. It is the negation of Any.
+*/
+func None[V any](predicate func(index int, value V) bool, slice []V) bool {
+	return !Any(predicate, slice)
+}
+
+/*
+Count returns the number of elements of the provided slice for which the specified
+predicate function returns true.
+
+Parameters:
+  - predicate: A function that takes an index and a value, and returns true if the
+    value should be counted.
+  - slice: The slice to count.
+
+Returns:
+  - The number of matching elements.
+*/
+func Count[V any](predicate func(index int, value V) bool, slice []V) int {
+	count := 0
+
+	for i := 0; i < len(slice); i++ {
+		if predicate(i, slice[i]) {
+			count++
+		}
+	}
+
+	return count
+}
+
+/*
+CountBy returns the number of elements of the provided slice for each key returned
+by the specified function.
+
+Parameters:
+  - keyFn: A function that takes a value and returns its key.
+  - slice: The slice to count.
+
+Returns:
+  - A new map from every key seen to the number of elements with that key.
+*/
+func CountBy[V any, K comparable](keyFn func(value V) K, slice []V) map[K]int {
+	counts := make(map[K]int)
+
+	for _, v := range slice {
+		counts[keyFn(v)]++
+	}
+
+	return counts
+}
+
 /*
 Filter returns a new slice containing only the elements from the provided slice
 for which the specified predicate function returns true.
@@ -71,6 +188,35 @@ func Filter[V any](predicate func(index int, value V) bool, slice []V) []V {
 	return result
 }
 
+/*
+Partition splits the provided slice into the elements for which the specified
+predicate function returns true and those for which it returns false, calling the
+predicate once per element.
+
+Parameters:
+  - predicate: A function that takes an index and a value, and returns true if the
+    value belongs to the matched slice.
+  - slice: The slice to split.
+
+Returns:
+  - matched: A new slice with the elements for which the predicate returned true.
+  - rest: A new slice with the other elements.
+*/
+func Partition[V any](predicate func(index int, value V) bool, slice []V) (matched, rest []V) {
+	matched = make([]V, 0, len(slice))
+	rest = make([]V, 0, len(slice))
+
+	for i, v := range slice {
+		if predicate(i, v) {
+			matched = append(matched, v)
+		} else {
+			rest = append(rest, v)
+		}
+	}
+
+	return matched, rest
+}
+
 /*
 ForEach applies the specified action function to each element of the provided slice.
 
@@ -134,3 +280,66 @@ func Reduce[V, A any](
 
 	return acc
 }
+
+/*
+ReduceWhile is Reduce with a reducer function that can end the fold early, once
+the remaining elements cannot change the result, as for a capped sum.
+
+Parameters:
+  - reducer: A function that takes an accumulator value, an index, and a value, and
+    returns a new accumulator value and whether to continue with the next element.
+  - slice: The slice to reduce.
+  - initialAccumulator: The initial value for the accumulator.
+
+Returns:
+  - The accumulator value returned by the last call to reducer, or
+    initialAccumulator for an empty slice.
+*/
+func ReduceWhile[V, A any](
+	reducer func(accumulator A, index int, value V) (A, bool),
+	slice []V,
+	initialAccumulator A,
+) A {
+	acc := initialAccumulator
+
+	for i, v := range slice {
+		var more bool
+
+		if acc, more = reducer(acc, i, v); !more {
+			break
+		}
+	}
+
+	return acc
+}
+
+/*
+MapReduce applies the specified mapper function to each element of the provided
+slice and folds the mapped values with the reducer function, in a single pass and
+without allocating the intermediate slice that Map followed by Reduce would.
+
+Parameters:
+  - mapper: A function that takes an index and a value, and returns the mapped
+    value.
+  - reducer: A function that takes an accumulator value and a mapped value, and
+    returns a new accumulator value.
+  - slice: The slice to reduce.
+  - initialAccumulator: The initial value for the accumulator.
+
+Returns:
+  - The final accumulator value.
+*/
+func MapReduce[V, M, A any](
+	mapper func(index int, value V) M,
+	reducer func(accumulator A, mapped M) A,
+	slice []V,
+	initialAccumulator A,
+) A {
+	acc := initialAccumulator
+
+	for i, v := range slice {
+		acc = reducer(acc, mapper(i, v))
+	}
+
+	return acc
+}
@@ -0,0 +1,203 @@
+/*
+Package assert provides test assertions about slices, with failure messages that
+point at the offending elements and their indexes.
+
+Every assertion reports failures through t.Errorf, so the test keeps running, and
+returns whether it passed.
+*/
+package assert
+
+import (
+	"fmt"
+	"strings"
+)
+
+/*
+TestingT is the subset of testing.TB used by the assertions. *testing.T and
+*testing.B satisfy it.
+*/
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...any)
+}
+
+/*
+ElementsMatch asserts that the two slices contain the same elements with the same
+multiplicities, in any order.
+
+Parameters:
+  - t: The test to report failures to.
+  - expected: The expected elements.
+  - actual: The actual elements.
+
+Returns:
+  - true if the assertion passed.
+*/
+func ElementsMatch[V comparable](t TestingT, expected, actual []V) bool {
+	t.Helper()
+
+	missing, extra := unmatched(expected, actual)
+	if len(missing) == 0 && len(extra) == 0 {
+		return true
+	}
+
+	var b strings.Builder
+	b.WriteString("elements do not match")
+	writeElements(&b, "missing from actual", expected, missing)
+	writeElements(&b, "unexpected in actual", actual, extra)
+	t.Errorf("%s", b.String())
+
+	return false
+}
+
+/*
+SubsetOf asserts that every element of subset is present in superset, regardless of
+order and multiplicity.
+
+Parameters:
+  - t: The test to report failures to.
+  - subset: The elements that must be present.
+  - superset: The elements to look in.
+
+Returns:
+  - true if the assertion passed.
+*/
+func SubsetOf[V comparable](t TestingT, subset, superset []V) bool {
+	t.Helper()
+
+	present := make(map[V]struct{}, len(superset))
+	for _, v := range superset {
+		present[v] = struct{}{}
+	}
+
+	var missing []int
+	for i, v := range subset {
+		if _, ok := present[v]; !ok {
+			missing = append(missing, i)
+		}
+	}
+
+	if len(missing) == 0 {
+		return true
+	}
+
+	var b strings.Builder
+	b.WriteString("not a subset")
+	writeElements(&b, "missing from superset", subset, missing)
+	t.Errorf("%s", b.String())
+
+	return false
+}
+
+/*
+SortedBy asserts that the slice is sorted according to less, that is, that no
+element sorts before the one preceding it.
+
+Parameters:
+  - t: The test to report failures to.
+  - slice: The slice to check.
+  - less: A function that reports whether a must sort before b.
+
+Returns:
+  - true if the assertion passed.
+*/
+func SortedBy[V any](t TestingT, slice []V, less func(a, b V) bool) bool {
+	t.Helper()
+
+	var b strings.Builder
+	failures := 0
+
+	for i := 1; i < len(slice); i++ {
+		if less(slice[i], slice[i-1]) {
+			failures++
+			fmt.Fprintf(&b, "\n  [%d] %v sorts before [%d] %v", i, slice[i], i-1, slice[i-1])
+		}
+	}
+
+	if failures == 0 {
+		return true
+	}
+
+	t.Errorf("not sorted: %d out-of-order element(s)%s", failures, b.String())
+
+	return false
+}
+
+/*
+NoDuplicates asserts that the slice contains no element more than once.
+
+Parameters:
+  - t: The test to report failures to.
+  - slice: The slice to check.
+
+Returns:
+  - true if the assertion passed.
+*/
+func NoDuplicates[V comparable](t TestingT, slice []V) bool {
+	t.Helper()
+
+	indexes := make(map[V][]int, len(slice))
+	var order []V
+
+	for i, v := range slice {
+		if _, ok := indexes[v]; !ok {
+			order = append(order, v)
+		}
+		indexes[v] = append(indexes[v], i)
+	}
+
+	var b strings.Builder
+	failures := 0
+
+	for _, v := range order {
+		if len(indexes[v]) > 1 {
+			failures++
+			fmt.Fprintf(&b, "\n  %v at indexes %v", v, indexes[v])
+		}
+	}
+
+	if failures == 0 {
+		return true
+	}
+
+	t.Errorf("duplicates found: %d value(s)%s", failures, b.String())
+
+	return false
+}
+
+// unmatched pairs up equal elements of expected and actual, and returns the
+// indexes of the elements of each slice that were left without a partner.
+func unmatched[V comparable](expected, actual []V) (missing, extra []int) {
+	available := make(map[V][]int, len(actual))
+	for i, v := range actual {
+		available[v] = append(available[v], i)
+	}
+
+	for i, v := range expected {
+		if len(available[v]) == 0 {
+			missing = append(missing, i)
+			continue
+		}
+		available[v] = available[v][1:]
+	}
+
+	for i, v := range actual {
+		if len(available[v]) > 0 && available[v][0] == i {
+			available[v] = available[v][1:]
+			extra = append(extra, i)
+		}
+	}
+
+	return missing, extra
+}
+
+func writeElements[V any](b *strings.Builder, title string, slice []V, indexes []int) {
+	if len(indexes) == 0 {
+		return
+	}
+
+	fmt.Fprintf(b, "\n%s (%d):", title, len(indexes))
+	for _, i := range indexes {
+		fmt.Fprintf(b, "\n  [%d] %v", i, slice[i])
+	}
+}
@@ -0,0 +1,70 @@
+package arrays
+
+import "fmt"
+
+/*
+OnDuplicate selects what KeyBy and Associate do when several elements produce the
+same key.
+*/
+type OnDuplicate int
+
+const (
+	// DuplicateKeepLast keeps the value of the last element with the key.
+	DuplicateKeepLast OnDuplicate = iota
+	// DuplicateKeepFirst keeps the value of the first element with the key.
+	DuplicateKeepFirst
+	// DuplicateError fails with ErrDuplicateKey.
+	DuplicateError
+)
+
+/*
+KeyBy builds a map from the key of every element of the provided slice to the
+element itself, for lookups by key.
+
+Parameters:
+  - keyFn: A function that takes a value and returns its key.
+  - slice: The slice to index.
+  - onDuplicate: What to do when several elements have the same key.
+
+Returns:
+  - A new map from keys to elements, or nil on error.
+  - nil, or an error wrapping ErrDuplicateKey that names the first repeated key.
+*/
+func KeyBy[V any, K comparable](keyFn func(value V) K, slice []V, onDuplicate OnDuplicate) (map[K]V, error) {
+	return Associate(func(value V) (K, V) { return keyFn(value), value }, slice, onDuplicate)
+}
+
+/*
+Associate builds a map from a key and a value extracted from every element of the
+provided slice.
+
+Parameters:
+  - fn: A function that takes a value and returns a map key and a map value.
+  - slice: The slice to read from.
+  - onDuplicate: What to do when several elements have the same key.
+
+Returns:
+  - A new map from extracted keys to extracted values, or nil on error.
+  - nil, or an error wrapping ErrDuplicateKey that names the first repeated key.
+*/
+func Associate[V any, K comparable, R any](fn func(value V) (K, R), slice []V, onDuplicate OnDuplicate) (map[K]R, error) {
+	result := make(map[K]R, len(slice))
+
+	for _, v := range slice {
+		k, r := fn(v)
+
+		if onDuplicate != DuplicateKeepLast {
+			if _, ok := result[k]; ok {
+				if onDuplicate == DuplicateError {
+					return nil, fmt.Errorf("%w: %v", ErrDuplicateKey, k)
+				}
+
+				continue
+			}
+		}
+
+		result[k] = r
+	}
+
+	return result, nil
+}
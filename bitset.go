@@ -0,0 +1,206 @@
+package arrays
+
+import "math/bits"
+
+/*
+Bitset is a compact set of indexes in [0, Len()), one bit per index. It is meant as
+a filter mask over a slice: masks built from several predicates can be combined
+with And, Or, and Not before the selected elements are materialized with
+SelectByBitset.
+*/
+type Bitset struct {
+	words []uint64
+	n     int
+}
+
+/*
+NewBitset creates an empty bitset for indexes in [0, length).
+*/
+func NewBitset(length int) *Bitset {
+	return &Bitset{words: make([]uint64, (length+63)/64), n: length}
+}
+
+/*
+BitsetFromIndexes creates a bitset for indexes in [0, length) with the provided
+indexes set. It panics if an index is out of range, like indexing a slice.
+*/
+func BitsetFromIndexes(length int, indexes []int) *Bitset {
+	b := NewBitset(length)
+
+	for _, i := range indexes {
+		b.Set(i)
+	}
+
+	return b
+}
+
+/*
+BitsetOf creates a bitset over the provided slice with the indexes of the elements
+for which the specified predicate function returns true.
+
+Parameters:
+  - predicate: A function that takes an index and a value, and returns true if the
+    index should be set.
+  - slice: The slice to evaluate.
+
+Returns:
+  - A bitset of the same length as the slice.
+*/
+func BitsetOf[V any](predicate func(index int, value V) bool, slice []V) *Bitset {
+	b := NewBitset(len(slice))
+
+	for i, v := range slice {
+		if predicate(i, v) {
+			b.Set(i)
+		}
+	}
+
+	return b
+}
+
+/*
+Len returns the number of indexes the bitset can hold.
+*/
+func (b *Bitset) Len() int {
+	return b.n
+}
+
+/*
+Set adds index i to the set. It panics if i is out of range.
+*/
+func (b *Bitset) Set(i int) {
+	b.check(i)
+	b.words[i/64] |= 1 << (i % 64)
+}
+
+/*
+Clear removes index i from the set. It panics if i is out of range.
+*/
+func (b *Bitset) Clear(i int) {
+	b.check(i)
+	b.words[i/64] &^= 1 << (i % 64)
+}
+
+/*
+Has reports whether index i is in the set. It returns false if i is out of range.
+*/
+func (b *Bitset) Has(i int) bool {
+	return i >= 0 && i < b.n && b.words[i/64]&(1<<(i%64)) != 0
+}
+
+/*
+Count returns the number of indexes in the set.
+*/
+func (b *Bitset) Count() int {
+	count := 0
+	for _, w := range b.words {
+		count += bits.OnesCount64(w)
+	}
+
+	return count
+}
+
+/*
+And returns a new bitset with the indexes present in both bitsets. The result has
+the length of b; indexes beyond the length of other are treated as absent.
+*/
+func (b *Bitset) And(other *Bitset) *Bitset {
+	result := NewBitset(b.n)
+
+	for i := range result.words {
+		if i < len(other.words) {
+			result.words[i] = b.words[i] & other.words[i]
+		}
+	}
+
+	result.trim()
+	return result
+}
+
+/*
+Or returns a new bitset with the indexes present in either bitset. The result has
+the length of b; indexes of other beyond that length are dropped.
+*/
+func (b *Bitset) Or(other *Bitset) *Bitset {
+	result := NewBitset(b.n)
+
+	for i := range result.words {
+		result.words[i] = b.words[i]
+		if i < len(other.words) {
+			result.words[i] |= other.words[i]
+		}
+	}
+
+	result.trim()
+	return result
+}
+
+/*
+Not returns a new bitset of the same length with every index in [0, Len()) flipped.
+*/
+func (b *Bitset) Not() *Bitset {
+	result := NewBitset(b.n)
+
+	for i, w := range b.words {
+		result.words[i] = ^w
+	}
+
+	result.trim()
+	return result
+}
+
+/*
+ToIndexes returns the indexes in the set, in ascending order.
+*/
+func (b *Bitset) ToIndexes() []int {
+	result := make([]int, 0, b.Count())
+
+	for i, w := range b.words {
+		for w != 0 {
+			result = append(result, i*64+bits.TrailingZeros64(w))
+			w &= w - 1
+		}
+	}
+
+	return result
+}
+
+/*
+SelectByBitset returns a new slice with the elements of the provided slice whose
+indexes are in the bitset, in order. Indexes beyond the end of the slice are
+ignored.
+
+Parameters:
+  - slice: The slice to select from.
+  - bitset: The indexes to select.
+
+Returns:
+  - A new slice with the selected elements.
+*/
+func SelectByBitset[V any](slice []V, bitset *Bitset) []V {
+	result := make([]V, 0, bitset.Count())
+
+	for _, i := range bitset.ToIndexes() {
+		if i >= len(slice) {
+			break
+		}
+
+		result = append(result, slice[i])
+	}
+
+	return result
+}
+
+func (b *Bitset) check(i int) {
+	if i < 0 || i >= b.n {
+		panic("arrays: bitset index out of range")
+	}
+}
+
+// trim clears the unused bits of the last word, so that they never show up as
+// indexes after Not or Or.
+func (b *Bitset) trim() {
+	if r := b.n % 64; r != 0 {
+		b.words[len(b.words)-1] &= 1<<r - 1
+	}
+}
@@ -0,0 +1,128 @@
+package arrays
+
+import (
+	"math"
+	"math/rand/v2"
+)
+
+/*
+DefaultBloomFalsePositiveRate is the false positive rate used by BloomFromSlice when
+the requested rate is not strictly between 0 and 1.
+*/
+const DefaultBloomFalsePositiveRate = 0.01
+
+/*
+Bloom is a probabilistic set built from a slice. Contains never reports false for an
+element that was added, but may report true for an element that was not, with a
+probability close to the false positive rate it was sized for.
+
+Elements are hashed as in HashSlice, with two seeds drawn at random for every
+filter, so a Bloom is meant for in-memory pre-filtering and cannot be persisted. A Bloom must be created with BloomFromSlice.
+*/
+type Bloom[V comparable] struct {
+	bits  []uint64
+	m     uint64
+	k     int
+	seed1 uint64
+	seed2 uint64
+}
+
+/*
+BloomFromSlice creates a Bloom filter containing every element of the provided
+slice, sized so that membership queries have the requested false positive rate.
+
+Parameters:
+  - slice: The elements to add.
+  - falsePositiveRate: The target probability that Contains reports true for an
+    element that was not added. Values outside (0, 1) fall back to
+    DefaultBloomFalsePositiveRate.
+
+Returns:
+  - A new Bloom filter.
+*/
+func BloomFromSlice[V comparable](slice []V, falsePositiveRate float64) *Bloom[V] {
+	if falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		falsePositiveRate = DefaultBloomFalsePositiveRate
+	}
+
+	n := math.Max(float64(len(slice)), 1)
+	m := uint64(math.Ceil(-n * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)))
+	m = max(m, 64)
+	k := max(int(math.Round(float64(m)/n*math.Ln2)), 1)
+
+	b := &Bloom[V]{
+		bits:  make([]uint64, (m+63)/64),
+		m:     m,
+		k:     k,
+		seed1: rand.Uint64(),
+		seed2: rand.Uint64(),
+	}
+
+	for _, v := range slice {
+		b.Add(v)
+	}
+
+	return b
+}
+
+/*
+Add adds a value to the filter. Adding more elements than the filter was sized for
+raises its false positive rate.
+
+Parameters:
+  - value: The value to add.
+*/
+func (b *Bloom[V]) Add(value V) {
+	h1, h2 := b.hashes(value)
+
+	for i := 0; i < b.k; i++ {
+		bit := (h1 + uint64(i)*h2) % b.m
+		b.bits[bit/64] |= 1 << (bit % 64)
+	}
+}
+
+/*
+Contains reports whether the value may have been added to the filter.
+
+Parameters:
+  - value: The value to look up.
+
+Returns:
+  - false if the value was definitely not added, true if it probably was.
+*/
+func (b *Bloom[V]) Contains(value V) bool {
+	h1, h2 := b.hashes(value)
+
+	for i := 0; i < b.k; i++ {
+		bit := (h1 + uint64(i)*h2) % b.m
+		if b.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+/*
+Predicate returns a predicate reporting whether a value may be in the filter, in the
+shape expected by Filter and the other functions of this package. It is meant as a
+cheap pre-pass before an exact check.
+
+Returns:
+  - A predicate backed by Contains.
+*/
+func (b *Bloom[V]) Predicate() func(index int, value V) bool {
+	return func(_ int, value V) bool {
+		return b.Contains(value)
+	}
+}
+
+// hashes returns the two base hashes combined by double hashing into the k bit
+// positions of a value. The second hash is forced odd so it never degenerates to 0.
+func (b *Bloom[V]) hashes(value V) (uint64, uint64) {
+	h1, h2 := newSliceHasher(b.seed1), newSliceHasher(b.seed2)
+	writeValue(&h1, value)
+	writeValue(&h2, value)
+
+	return mix64(h1.Sum64()), mix64(h2.Sum64()) | 1
+}
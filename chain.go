@@ -0,0 +1,76 @@
+package arrays
+
+import "sort"
+
+/*
+Chained wraps a slice so that same-type transformations can be written as a chain of
+method calls instead of nested function calls.
+
+Every step runs eagerly, as soon as it is called. The slice passed to Chain is never
+modified, but Sort reorders the result of a previous step in place, so intermediate
+chain values should not be reused once a later step has run.
+*/
+type Chained[V any] struct {
+	slice []V
+	owned bool
+}
+
+/*
+Chain wraps the provided slice for method chaining, for example
+Chain(s).Filter(p).Map(f).Sort(less).Value().
+
+Parameters:
+  - slice: The slice to wrap.
+
+Returns:
+  - A chain over the slice.
+*/
+func Chain[V any](slice []V) Chained[V] {
+	return Chained[V]{slice: slice}
+}
+
+/*
+Filter keeps only the elements for which the specified predicate function returns
+true. See Filter.
+*/
+func (c Chained[V]) Filter(predicate func(index int, value V) bool) Chained[V] {
+	return Chained[V]{slice: Filter(predicate, c.slice), owned: true}
+}
+
+/*
+Map replaces every element with the result of the specified transform function. See
+Map.
+*/
+func (c Chained[V]) Map(transform func(index int, value V) V) Chained[V] {
+	return Chained[V]{slice: Map(transform, c.slice), owned: true}
+}
+
+/*
+Sort stably sorts the elements with the specified less function.
+*/
+func (c Chained[V]) Sort(less func(a, b V) bool) Chained[V] {
+	s := c.slice
+	if !c.owned {
+		s = make([]V, len(c.slice))
+		copy(s, c.slice)
+	}
+
+	sort.SliceStable(s, func(i, j int) bool { return less(s[i], s[j]) })
+
+	return Chained[V]{slice: s, owned: true}
+}
+
+/*
+Len returns the number of elements in the chain.
+*/
+func (c Chained[V]) Len() int {
+	return len(c.slice)
+}
+
+/*
+Value returns the resulting slice. If no step has run, this is the slice originally
+passed to Chain.
+*/
+func (c Chained[V]) Value() []V {
+	return c.slice
+}
@@ -0,0 +1,156 @@
+package arrays
+
+import "context"
+
+/*
+ToChannel sends the elements of the provided slice, in order, on a new channel from
+a new goroutine, and closes the channel after the last one. If the context ends
+first, the goroutine stops sending and closes the channel early, so it never leaks
+as long as the context is eventually cancelled or the channel drained.
+
+Parameters:
+  - ctx: The context controlling cancellation.
+  - slice: The slice to send. It must not be modified until the channel is closed.
+  - buffer: The capacity of the channel.
+
+Returns:
+  - The channel.
+*/
+func ToChannel[V any](ctx context.Context, slice []V, buffer int) <-chan V {
+	out := make(chan V, buffer)
+
+	go func() {
+		defer close(out)
+
+		for _, v := range slice {
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+/*
+FromChannel receives values from the provided channel until it is closed, and
+returns them in the order received.
+
+Parameters:
+  - ctx: The context controlling cancellation.
+  - ch: The channel to drain.
+
+Returns:
+  - A new slice with the values received, including those received before the
+    context ended.
+  - nil, or ctx.Err() if the context ended before the channel was closed.
+*/
+func FromChannel[V any](ctx context.Context, ch <-chan V) ([]V, error) {
+	var result []V
+
+	for {
+		select {
+		case v, ok := <-ch:
+			if !ok {
+				return result, nil
+			}
+
+			result = append(result, v)
+		case <-ctx.Done():
+			return result, ctx.Err()
+		}
+	}
+}
+
+/*
+MapChan applies the specified transform function to every value received from the
+provided channel, and sends the results, in order, on a new channel from a new
+goroutine. The output channel is closed when the input is closed or the context
+ends.
+
+Parameters:
+  - ctx: The context controlling cancellation.
+  - transform: A function that takes the index of a value in the stream and the
+    value, and returns the transformed value.
+  - in: The channel to read from.
+
+Returns:
+  - The unbuffered output channel.
+*/
+func MapChan[V, R any](ctx context.Context, transform func(index int, value V) R, in <-chan V) <-chan R {
+	out := make(chan R)
+
+	go func() {
+		defer close(out)
+
+		for i := 0; ; i++ {
+			v, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+
+			select {
+			case out <- transform(i, v):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+/*
+FilterChan sends the values received from the provided channel for which the
+specified predicate function returns true, in order, on a new channel from a new
+goroutine. The output channel is closed when the input is closed or the context
+ends.
+
+Parameters:
+  - ctx: The context controlling cancellation.
+  - predicate: A function that takes the index of a value in the stream and the
+    value, and returns true if the value should be passed on.
+  - in: The channel to read from.
+
+Returns:
+  - The unbuffered output channel.
+*/
+func FilterChan[V any](ctx context.Context, predicate func(index int, value V) bool, in <-chan V) <-chan V {
+	out := make(chan V)
+
+	go func() {
+		defer close(out)
+
+		for i := 0; ; i++ {
+			v, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+
+			if !predicate(i, v) {
+				continue
+			}
+
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// receive returns the next value from in, or false once in is closed or ctx ends.
+func receive[V any](ctx context.Context, in <-chan V) (V, bool) {
+	select {
+	case v, ok := <-in:
+		return v, ok
+	case <-ctx.Done():
+		var zero V
+		return zero, false
+	}
+}
@@ -0,0 +1,71 @@
+package arrays
+
+/*
+FirstNonEmpty returns the first of the provided slices that has at least one
+element, which makes fallback chains across candidate results a single call.
+
+Parameters:
+  - slices: The candidate slices, in order of preference.
+
+Returns:
+  - The first non-empty slice, or nil if all of them are empty.
+*/
+func FirstNonEmpty[V any](slices ...[]V) []V {
+	for _, s := range slices {
+		if len(s) > 0 {
+			return s
+		}
+	}
+
+	return nil
+}
+
+/*
+LongestOf returns the provided slice with the most elements. Ties go to the earliest
+one.
+
+Parameters:
+  - slices: The candidate slices.
+
+Returns:
+  - The longest slice, or nil if no slices are given.
+*/
+func LongestOf[V any](slices ...[]V) []V {
+	if len(slices) == 0 {
+		return nil
+	}
+
+	longest := slices[0]
+	for _, s := range slices[1:] {
+		if len(s) > len(longest) {
+			longest = s
+		}
+	}
+
+	return longest
+}
+
+/*
+ShortestOf returns the provided slice with the fewest elements. Ties go to the
+earliest one.
+
+Parameters:
+  - slices: The candidate slices.
+
+Returns:
+  - The shortest slice, or nil if no slices are given.
+*/
+func ShortestOf[V any](slices ...[]V) []V {
+	if len(slices) == 0 {
+		return nil
+	}
+
+	shortest := slices[0]
+	for _, s := range slices[1:] {
+		if len(s) < len(shortest) {
+			shortest = s
+		}
+	}
+
+	return shortest
+}
@@ -0,0 +1,130 @@
+package arrays
+
+/*
+Chunk splits the provided slice into consecutive chunks of at most size elements.
+Every chunk holds exactly size elements except the last one, which holds the
+remaining elements and may be shorter; no chunk is ever empty.
+
+The chunks share the backing array of the input, so writing to an element of a
+chunk writes to the input. Their capacity is clipped, so appending to a chunk never
+overwrites the next one. Use ChunkCopy for independent chunks.
+
+Parameters:
+  - slice: The slice to split.
+  - size: The maximum number of elements per chunk. If it is not positive, nil is
+    returned.
+
+Returns:
+  - The chunks, in order.
+*/
+func Chunk[V any](slice []V, size int) [][]V {
+	if size <= 0 {
+		return nil
+	}
+
+	result := make([][]V, 0, (len(slice)+size-1)/size)
+
+	ForEachChunk(slice, size, func(_ int, chunk []V) {
+		result = append(result, chunk)
+	})
+
+	return result
+}
+
+/*
+ChunkCopy splits the provided slice like Chunk, but copies the elements into new
+memory, so the chunks can be modified without affecting the input or each other.
+
+Parameters:
+  - slice: The slice to split.
+  - size: The maximum number of elements per chunk. If it is not positive, nil is
+    returned.
+
+Returns:
+  - The chunks, in order.
+*/
+func ChunkCopy[V any](slice []V, size int) [][]V {
+	if size <= 0 {
+		return nil
+	}
+
+	backing := make([]V, len(slice))
+	copy(backing, slice)
+
+	return Chunk(backing, size)
+}
+
+/*
+ForEachChunk calls the specified action function with consecutive subslices of the
+provided slice, each holding at most size elements. The last chunk holds the
+remaining elements and may be shorter.
+
+Unlike Chunk, ForEachChunk allocates nothing: each chunk shares the backing array
+of the input, with its capacity clipped so that appending to it never overwrites
+the next chunk.
+
+Parameters:
+  - slice: The slice to iterate over.
+  - size: The maximum number of elements per chunk. If size is not positive, action
+    is never called.
+  - action: A function that takes the index of the chunk and the chunk itself.
+*/
+func ForEachChunk[V any](slice []V, size int, action func(chunkIndex int, chunk []V)) {
+	if size <= 0 {
+		return
+	}
+
+	for i, start := 0, 0; start < len(slice); i, start = i+1, start+size {
+		end := start + size
+		if end > len(slice) {
+			end = len(slice)
+		}
+
+		action(i, slice[start:end:end])
+	}
+}
+
+/*
+Windows returns the sliding windows of the provided slice: subslices of exactly size
+elements, starting at every step-th element. Windows that would run past the end of
+the slice are not returned, so a slice shorter than size has no windows.
+
+Like Chunk, the windows share the backing array of the input and have their
+capacity clipped; overlapping windows share elements with each other. Use
+WindowsCopy for independent windows.
+
+Parameters:
+  - slice: The slice to split.
+  - size: The number of elements per window.
+  - step: The distance between the starts of consecutive windows.
+
+Returns:
+  - The windows, in order, or nil if size or step is not positive.
+*/
+func Windows[V any](slice []V, size, step int) [][]V {
+	if size <= 0 || step <= 0 || len(slice) < size {
+		return nil
+	}
+
+	result := make([][]V, 0, (len(slice)-size)/step+1)
+
+	for start := 0; start+size <= len(slice); start += step {
+		result = append(result, slice[start:start+size:start+size])
+	}
+
+	return result
+}
+
+/*
+WindowsCopy returns the same windows as Windows, but copies every window into new
+memory, so the windows can be modified without affecting the input or each other.
+*/
+func WindowsCopy[V any](slice []V, size, step int) [][]V {
+	windows := Windows(slice, size, step)
+
+	for i, w := range windows {
+		windows[i] = append([]V(nil), w...)
+	}
+
+	return windows
+}
@@ -0,0 +1,122 @@
+package arrays
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+)
+
+/*
+EncodeChunked writes the provided slice as a sequence of independently decodable
+chunks of at most chunkSize elements.
+
+Each chunk is a self-contained gob encoding of a []V, prefixed with its length in
+bytes as an unsigned varint. Since chunks do not depend on each other, the output
+can be appended to, spilled to disk piece by piece, and processed one chunk at a
+time with DecodeChunkedEach.
+
+Parameters:
+  - w: The destination of the encoded data.
+  - slice: The values to write. V must be encodable with encoding/gob.
+  - chunkSize: The maximum number of elements per chunk. Non-positive values write
+    the whole slice as a single chunk.
+
+Returns:
+  - The first encode or write error, if any.
+*/
+func EncodeChunked[V any](w io.Writer, slice []V, chunkSize int) error {
+	if chunkSize <= 0 {
+		chunkSize = max(len(slice), 1)
+	}
+
+	var (
+		buf    bytes.Buffer
+		prefix [binary.MaxVarintLen64]byte
+		err    error
+	)
+
+	ForEachChunk(slice, chunkSize, func(chunkIndex int, chunk []V) {
+		if err != nil {
+			return
+		}
+
+		buf.Reset()
+		if err = gob.NewEncoder(&buf).Encode(chunk); err != nil {
+			err = fmt.Errorf("arrays: chunk %d: %w", chunkIndex, err)
+			return
+		}
+
+		n := binary.PutUvarint(prefix[:], uint64(buf.Len()))
+		if _, err = w.Write(prefix[:n]); err == nil {
+			_, err = w.Write(buf.Bytes())
+		}
+	})
+
+	return err
+}
+
+/*
+DecodeChunked reads every chunk written by EncodeChunked and concatenates them.
+
+Parameters:
+  - r: The encoded data.
+
+Returns:
+  - The decoded values, in order.
+  - The first read or decode error, if any.
+*/
+func DecodeChunked[V any](r io.Reader) ([]V, error) {
+	var result []V
+
+	err := DecodeChunkedEach(r, func(_ int, chunk []V) error {
+		result = append(result, chunk...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+/*
+DecodeChunkedEach reads the chunks written by EncodeChunked one at a time and passes
+each of them to fn, so that only one chunk is held in memory.
+
+Parameters:
+  - r: The encoded data.
+  - fn: A function called with the index and the contents of each chunk. Returning
+    an error stops reading.
+
+Returns:
+  - The first read, decode, or fn error, if any.
+*/
+func DecodeChunkedEach[V any](r io.Reader, fn func(chunkIndex int, chunk []V) error) error {
+	br := bufio.NewReader(r)
+
+	for i := 0; ; i++ {
+		size, err := binary.ReadUvarint(br)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("arrays: chunk %d: %w", i, err)
+		}
+
+		var chunk []V
+		if err := gob.NewDecoder(io.LimitReader(br, int64(size))).Decode(&chunk); err != nil {
+			if errors.Is(err, io.EOF) {
+				err = io.ErrUnexpectedEOF
+			}
+			return fmt.Errorf("arrays: chunk %d: %w", i, err)
+		}
+
+		if err := fn(i, chunk); err != nil {
+			return err
+		}
+	}
+}
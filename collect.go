@@ -0,0 +1,29 @@
+package arrays
+
+import "errors"
+
+/*
+ForEachCollectErr applies the specified action function to each element of the
+provided slice, continuing past failures, and reports every error at once.
+
+Parameters:
+  - action: A function that takes an index and a value, performs some action on the
+    value, and returns an error if it failed.
+  - slice: The slice to iterate over.
+
+Returns:
+  - nil if every call succeeded, or an error joining one *IndexError per failed
+    element, in index order. The individual errors can be retrieved with errors.As
+    or through its Unwrap() []error method.
+*/
+func ForEachCollectErr[V any](action func(index int, value V) error, slice []V) error {
+	var errs []error
+
+	for i, v := range slice {
+		if err := action(i, v); err != nil {
+			errs = append(errs, &IndexError{Index: i, Err: err})
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,221 @@
+/*
+Package csvio converts between CSV data and typed slices, so that pipelines built on
+the arrays package can start and end with CSV files.
+
+Reading comes in three shapes: ReadAll loads every record, ReadEach streams records
+one by one, and ReadChunks streams them in fixed-size batches. Writing mirrors it:
+WriteAll writes a slice, and WriteEach and WriteChunks write a sequence, flushing
+after every record or every batch of records.
+*/
+package csvio
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"slices"
+)
+
+/*
+ErrSkip can be returned by a decode function to skip the current record, for
+example a header row. It is never returned by the functions of this package.
+*/
+var ErrSkip = errors.New("csvio: skip record")
+
+/*
+ReadAll decodes every record of the provided CSV data.
+
+Parameters:
+  - r: The CSV data.
+  - decode: A function that converts a record into a value. It may return ErrSkip to
+    leave the record out.
+
+Returns:
+  - The decoded values, in the order of the records.
+  - The first read or decode error, annotated with its line number.
+*/
+func ReadAll[T any](r io.Reader, decode func(record []string) (T, error)) ([]T, error) {
+	var result []T
+
+	err := ReadEach(r, decode, func(value T) error {
+		result = append(result, value)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+/*
+ReadEach decodes the records of the provided CSV data one by one and passes each
+value to fn, without holding more than one record in memory.
+
+Parameters:
+  - r: The CSV data.
+  - decode: A function that converts a record into a value. It may return ErrSkip to
+    leave the record out.
+  - fn: A function called with each decoded value. Returning an error stops reading.
+
+Returns:
+  - The first read, decode, or fn error. Read and decode errors are annotated with
+    their line number.
+*/
+func ReadEach[T any](r io.Reader, decode func(record []string) (T, error), fn func(value T) error) error {
+	cr := csv.NewReader(r)
+
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		value, err := decode(record)
+		if errors.Is(err, ErrSkip) {
+			continue
+		}
+		if err != nil {
+			line, _ := cr.FieldPos(0)
+			return fmt.Errorf("csvio: line %d: %w", line, err)
+		}
+
+		if err := fn(value); err != nil {
+			return err
+		}
+	}
+}
+
+/*
+ReadChunks decodes the records of the provided CSV data and passes them to fn in
+batches of at most size values. The last batch may be shorter.
+
+Parameters:
+  - r: The CSV data.
+  - decode: A function that converts a record into a value. It may return ErrSkip to
+    leave the record out.
+  - size: The maximum number of values per batch. If it is not positive, nothing is
+    read and fn is never called, as with arrays.Chunk.
+  - fn: A function called with each batch. The batch is reused after fn returns, so
+    fn must copy it to retain it. Returning an error stops reading.
+
+Returns:
+  - The first read, decode, or fn error. Read and decode errors are annotated with
+    their line number.
+*/
+func ReadChunks[T any](
+	r io.Reader,
+	decode func(record []string) (T, error),
+	size int,
+	fn func(chunk []T) error,
+) error {
+	if size <= 0 {
+		return nil
+	}
+
+	chunk := make([]T, 0, size)
+
+	err := ReadEach(r, decode, func(value T) error {
+		chunk = append(chunk, value)
+		if len(chunk) < size {
+			return nil
+		}
+
+		err := fn(chunk)
+		chunk = chunk[:0]
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(chunk) > 0 {
+		return fn(chunk)
+	}
+
+	return nil
+}
+
+/*
+WriteAll encodes every element of the provided slice as a CSV record.
+
+Parameters:
+  - w: The destination of the CSV data.
+  - slice: The values to write.
+  - encode: A function that converts a value into a record.
+
+Returns:
+  - The first write error, if any.
+*/
+func WriteAll[T any](w io.Writer, slice []T, encode func(value T) []string) error {
+	return writeSeq(w, slices.Values(slice), encode, 0)
+}
+
+/*
+WriteEach encodes the values of the provided sequence as CSV records as they are
+produced, flushing each record to w before pulling the next value, so that a
+consumer sees it at once and no more than one record is buffered.
+
+Parameters:
+  - w: The destination of the CSV data.
+  - seq: The values to write.
+  - encode: A function that converts a value into a record.
+
+Returns:
+  - The first write error, if any. The sequence is not consumed any further after
+    an error.
+*/
+func WriteEach[T any](w io.Writer, seq iter.Seq[T], encode func(value T) []string) error {
+	return writeSeq(w, seq, encode, 1)
+}
+
+/*
+WriteChunks is WriteEach that flushes after every size records instead of every
+record, trading latency for fewer writes to w.
+
+Parameters:
+  - w: The destination of the CSV data.
+  - seq: The values to write.
+  - encode: A function that converts a value into a record.
+  - size: The number of records per flush. If it is not positive, nothing is
+    written and seq is never pulled, as with arrays.Chunk.
+
+Returns:
+  - The first write error, if any. The sequence is not consumed any further after
+    an error.
+*/
+func WriteChunks[T any](w io.Writer, seq iter.Seq[T], encode func(value T) []string, size int) error {
+	if size <= 0 {
+		return nil
+	}
+
+	return writeSeq(w, seq, encode, size)
+}
+
+// writeSeq writes the records of seq, flushing after every flushEvery records, or
+// only at the end if flushEvery is 0.
+func writeSeq[T any](w io.Writer, seq iter.Seq[T], encode func(value T) []string, flushEvery int) error {
+	cw := csv.NewWriter(w)
+	n := 0
+
+	for v := range seq {
+		if err := cw.Write(encode(v)); err != nil {
+			return err
+		}
+
+		if n++; flushEvery > 0 && n%flushEvery == 0 {
+			cw.Flush()
+			if err := cw.Error(); err != nil {
+				return err
+			}
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
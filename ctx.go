@@ -0,0 +1,204 @@
+package arrays
+
+import (
+	"context"
+	"time"
+)
+
+/*
+MapCtx is Map that stops early when the provided context is cancelled or its
+deadline passes. The context is checked before each element.
+
+Parameters:
+  - ctx: The context controlling cancellation.
+  - transform: A function that takes an index and a value, and returns the
+    transformed value.
+  - slice: The slice to transform.
+
+Returns:
+  - A new slice containing the transformed values, or nil if the context ended.
+  - nil, or ctx.Err() if the context ended before every element was transformed.
+*/
+func MapCtx[V, R any](ctx context.Context, transform func(index int, value V) R, slice []V) ([]R, error) {
+	result := make([]R, len(slice))
+
+	for i, v := range slice {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		result[i] = transform(i, v)
+	}
+
+	return result, nil
+}
+
+/*
+FilterCtx is Filter that stops early when the provided context is cancelled or its
+deadline passes. The context is checked before each element.
+
+Parameters:
+  - ctx: The context controlling cancellation.
+  - predicate: A function that takes an index and a value, and returns true if the
+    value should be included in the result slice.
+  - slice: The slice to filter.
+
+Returns:
+  - A new slice containing the matching elements, or nil if the context ended.
+  - nil, or ctx.Err() if the context ended before every element was checked.
+*/
+func FilterCtx[V any](ctx context.Context, predicate func(index int, value V) bool, slice []V) ([]V, error) {
+	result := make([]V, 0, len(slice))
+
+	for i, v := range slice {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if predicate(i, v) {
+			result = append(result, v)
+		}
+	}
+
+	return result, nil
+}
+
+/*
+ForEachCtx is ForEach that stops early when the provided context is cancelled or its
+deadline passes. The context is checked before each element.
+
+Parameters:
+  - ctx: The context controlling cancellation.
+  - action: A function that takes an index and a value, and performs some action on
+    the value.
+  - slice: The slice to iterate over.
+
+Returns:
+  - nil, or ctx.Err() if the context ended before every element was visited.
+*/
+func ForEachCtx[V any](ctx context.Context, action func(index int, value V), slice []V) error {
+	for i, v := range slice {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		action(i, v)
+	}
+
+	return nil
+}
+
+/*
+ReduceCtx is Reduce that stops early when the provided context is cancelled or its
+deadline passes. The context is checked before each element.
+
+Parameters:
+  - ctx: The context controlling cancellation.
+  - reducer: A function that takes an accumulator value, an index, and a value, and
+    returns a new accumulator value.
+  - slice: The slice to reduce.
+  - initialAccumulator: The initial value for the accumulator.
+
+Returns:
+  - The final accumulator value, or the accumulator reached so far if the context
+    ended.
+  - nil, or ctx.Err() if the context ended before every element was folded.
+*/
+func ReduceCtx[V, A any](
+	ctx context.Context,
+	reducer func(accumulator A, index int, value V) A,
+	slice []V,
+	initialAccumulator A,
+) (A, error) {
+	acc := initialAccumulator
+
+	for i, v := range slice {
+		if err := ctx.Err(); err != nil {
+			return acc, err
+		}
+
+		acc = reducer(acc, i, v)
+	}
+
+	return acc, nil
+}
+
+/*
+MapWithTimeout is TryMap with a deadline for every element. Each call to transform
+gets a context that expires after perElementTimeout; if the call has not returned
+by then, the element is recorded as timed out and the pass moves on to the next
+one. Errors are recorded per element rather than stopping the pass.
+
+A timed-out call keeps running in the background until transform returns, so
+transform should watch its context. Its result is discarded. If transform panics
+before its deadline, the panic is re-raised in the calling goroutine.
+
+Parameters:
+  - transform: A function that takes a context, an index and a value, and returns
+    the transformed value or an error.
+  - slice: The slice to transform.
+  - perElementTimeout: How long each call may take.
+
+Returns:
+  - A new slice containing the transformed values, with the zero value for every
+    element that failed or timed out.
+  - A new slice, aligned with the input, holding nil for every element that was
+    transformed, the error returned by transform, or context.DeadlineExceeded for
+    the elements that timed out. It is nil if every element was transformed.
+*/
+func MapWithTimeout[V, R any](
+	transform func(ctx context.Context, index int, value V) (R, error),
+	slice []V,
+	perElementTimeout time.Duration,
+) ([]R, []error) {
+	type outcome struct {
+		result   R
+		err      error
+		panicked any
+	}
+
+	result := make([]R, len(slice))
+	var errs []error
+
+	for i, v := range slice {
+		ctx, cancel := context.WithTimeout(context.Background(), perElementTimeout)
+		done := make(chan outcome, 1)
+
+		go func() {
+			var o outcome
+			defer func() {
+				if r := recover(); r != nil {
+					o.panicked = r
+				}
+				done <- o
+			}()
+
+			o.result, o.err = transform(ctx, i, v)
+		}()
+
+		var err error
+		select {
+		case o := <-done:
+			if o.panicked != nil {
+				cancel()
+				panic(o.panicked)
+			}
+
+			result[i], err = o.result, o.err
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+
+		cancel()
+
+		if err != nil {
+			if errs == nil {
+				errs = make([]error, len(slice))
+			}
+
+			errs[i] = err
+		}
+	}
+
+	return result, errs
+}
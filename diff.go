@@ -0,0 +1,394 @@
+package arrays
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+/*
+Change is an element whose key is present in both compared slices but whose value
+differs.
+
+Fields:
+  - Old: The element from the old slice.
+  - New: The element from the new slice.
+*/
+type Change[V any] struct {
+	Old V
+	New V
+}
+
+/*
+KeyedDiff is the result of DiffByKey.
+
+Fields:
+  - Added: Elements of the new slice whose key is not in the old slice, in new order.
+  - Removed: Elements of the old slice whose key is not in the new slice, in old
+    order.
+  - Changed: Elements present in both slices that are not equal, in new order.
+  - Unchanged: Elements present in both slices that are equal, taken from the new
+    slice, in new order.
+*/
+type KeyedDiff[V any] struct {
+	Added     []V
+	Removed   []V
+	Changed   []Change[V]
+	Unchanged []V
+}
+
+/*
+DiffByKey compares two snapshots of a keyed collection, matching elements by key.
+
+Keys are expected to be unique within each slice. If a key is repeated, only its
+first occurrence takes part in the comparison and the others are ignored.
+
+Parameters:
+  - oldSlice: The previous snapshot.
+  - newSlice: The current snapshot.
+  - key: A function that takes a value and returns its key.
+  - equal: A function that reports whether two elements with the same key are equal.
+
+Returns:
+  - The added, removed, changed, and unchanged elements.
+*/
+func DiffByKey[V any, K comparable](
+	oldSlice, newSlice []V,
+	key func(value V) K,
+	equal func(a, b V) bool,
+) KeyedDiff[V] {
+	var diff KeyedDiff[V]
+
+	oldByKey := make(map[K]int, len(oldSlice))
+	for i, v := range oldSlice {
+		k := key(v)
+		if _, ok := oldByKey[k]; !ok {
+			oldByKey[k] = i
+		}
+	}
+
+	seen := make(map[K]struct{}, len(newSlice))
+	for _, v := range newSlice {
+		k := key(v)
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+
+		i, ok := oldByKey[k]
+		switch {
+		case !ok:
+			diff.Added = append(diff.Added, v)
+		case equal(oldSlice[i], v):
+			diff.Unchanged = append(diff.Unchanged, v)
+		default:
+			diff.Changed = append(diff.Changed, Change[V]{Old: oldSlice[i], New: v})
+		}
+	}
+
+	for i, v := range oldSlice {
+		k := key(v)
+		if _, ok := seen[k]; !ok && oldByKey[k] == i {
+			diff.Removed = append(diff.Removed, v)
+		}
+	}
+
+	return diff
+}
+
+/*
+Conflict is a key that was changed in incompatible ways on both sides of a
+three-way merge. A nil field means the key is absent from that slice.
+
+Fields:
+  - Base: The element from the common ancestor.
+  - Mine: The element from the local side.
+  - Theirs: The element from the remote side.
+*/
+type Conflict[V any] struct {
+	Base   *V
+	Mine   *V
+	Theirs *V
+}
+
+/*
+Reconcile performs a keyed three-way merge of two slices that both derive from a
+common base.
+
+For each key, a change made on only one side (an addition, a removal, or a
+modification) is applied, and identical changes on both sides are applied once.
+When both sides changed the same key differently, the key is reported as a conflict
+and the local version is kept in the result (or left out, if it was removed
+locally), so that no local edit is silently lost.
+
+Keys are expected to be unique within each slice. The result keeps the order of
+mine, followed by the elements added only on their side, in their order.
+
+Parameters:
+  - base: The common ancestor.
+  - mine: The local version.
+  - theirs: The remote version.
+  - key: A function that takes a value and returns its key.
+  - equal: A function that reports whether two elements with the same key are equal.
+
+Returns:
+  - The merged slice.
+  - The conflicts, in the order their keys appear in the result, followed by those
+    whose key was removed locally.
+*/
+func Reconcile[V any, K comparable](
+	base, mine, theirs []V,
+	key func(value V) K,
+	equal func(a, b V) bool,
+) ([]V, []Conflict[V]) {
+	index := func(slice []V) map[K]*V {
+		m := make(map[K]*V, len(slice))
+		for i := range slice {
+			k := key(slice[i])
+			if _, ok := m[k]; !ok {
+				m[k] = &slice[i]
+			}
+		}
+		return m
+	}
+
+	same := func(a, b *V) bool {
+		if a == nil || b == nil {
+			return a == nil && b == nil
+		}
+		return equal(*a, *b)
+	}
+
+	baseByKey, mineByKey, theirsByKey := index(base), index(mine), index(theirs)
+
+	var (
+		result    = make([]V, 0, len(mine)+len(theirs))
+		conflicts []Conflict[V]
+		removed   []Conflict[V]
+	)
+
+	resolve := func(k K) {
+		b, m, t := baseByKey[k], mineByKey[k], theirsByKey[k]
+
+		var pick *V
+		switch {
+		case same(m, t), same(b, t):
+			pick = m
+		case same(b, m):
+			pick = t
+		default:
+			pick = m
+			if m == nil {
+				removed = append(removed, Conflict[V]{Base: b, Mine: m, Theirs: t})
+			} else {
+				conflicts = append(conflicts, Conflict[V]{Base: b, Mine: m, Theirs: t})
+			}
+		}
+
+		if pick != nil {
+			result = append(result, *pick)
+		}
+	}
+
+	visited := make(map[K]struct{}, len(mine)+len(theirs))
+	for _, slice := range [][]V{mine, theirs, base} {
+		for _, v := range slice {
+			k := key(v)
+			if _, ok := visited[k]; ok {
+				continue
+			}
+
+			visited[k] = struct{}{}
+			resolve(k)
+		}
+	}
+
+	return result, append(conflicts, removed...)
+}
+
+type editKind int
+
+const (
+	editEqual editKind = iota
+	editDelete
+	editInsert
+)
+
+// edit is one step of an edit script turning a into b. AIndex is meaningful for
+// editEqual and editDelete, BIndex for editEqual and editInsert.
+type edit struct {
+	kind   editKind
+	aIndex int
+	bIndex int
+}
+
+// editScript returns a shortest edit script turning a into b, computed with
+// Myers' O((N+M)D) algorithm.
+func editScript[V comparable](a, b []V) []edit {
+	n, m := len(a), len(b)
+	limit := n + m
+	offset := limit + 1
+	v := make([]int, 2*offset+1)
+
+	// trace[d] holds the diagonals -d-1 to d+1 of v as they were before round d,
+	// which is all that backtracking through round d reads. Storing just that
+	// window keeps the trace at O(D²) instead of O(D·(N+M)).
+	var trace [][]int
+
+search:
+	for d := 0; d <= limit; d++ {
+		trace = append(trace, append([]int(nil), v[offset-d-1:offset+d+2]...))
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+
+			v[offset+k] = x
+			if x >= n && y >= m {
+				break search
+			}
+		}
+	}
+
+	var script []edit
+
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		k := x - y
+
+		var prevK int
+		if k == -d || (k != d && v[d+k] < v[d+k+2]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+
+		prevX := v[d+1+prevK]
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			x--
+			y--
+			script = append(script, edit{kind: editEqual, aIndex: x, bIndex: y})
+		}
+
+		if d > 0 {
+			if x == prevX {
+				script = append(script, edit{kind: editInsert, aIndex: -1, bIndex: prevY})
+			} else {
+				script = append(script, edit{kind: editDelete, aIndex: prevX, bIndex: -1})
+			}
+		}
+
+		x, y = prevX, prevY
+	}
+
+	for i, j := 0, len(script)-1; i < j; i, j = i+1, j-1 {
+		script[i], script[j] = script[j], script[i]
+	}
+
+	return script
+}
+
+/*
+FormatDiffOptions configures FormatDiff. The zero value shows only the differing
+elements, formatted with %v.
+
+Fields:
+  - Context: The number of equal elements shown around each change.
+  - Format: A function that renders an element. Nil means fmt's %v.
+*/
+type FormatDiffOptions[V any] struct {
+	Context int
+	Format  func(value V) string
+}
+
+/*
+FormatDiff renders a human-readable, element-by-element diff turning a into b, for
+use in test failure messages.
+
+Each line starts with '-' for an element only in a, '+' for an element only in b,
+or ' ' for a context element in both, followed by the index of the element in a
+and in b, aligned in columns. Runs of equal elements beyond the requested context
+are collapsed into a "..." line.
+
+Parameters:
+  - a: The first slice, typically the expected value.
+  - b: The second slice, typically the actual value.
+  - opts: Formatting options.
+
+Returns:
+  - The rendered diff, or an empty string if the slices are equal.
+*/
+func FormatDiff[V comparable](a, b []V, opts FormatDiffOptions[V]) string {
+	script := editScript(a, b)
+
+	changed := make([]bool, len(script))
+	anyChange := false
+	for i, e := range script {
+		if e.kind != editEqual {
+			anyChange = true
+			for j := max(i-opts.Context, 0); j <= min(i+opts.Context, len(script)-1); j++ {
+				changed[j] = true
+			}
+		}
+	}
+
+	if !anyChange {
+		return ""
+	}
+
+	format := opts.Format
+	if format == nil {
+		format = func(value V) string { return fmt.Sprint(value) }
+	}
+
+	width := len(strconv.Itoa(max(len(a), len(b), 1) - 1))
+	column := func(index int) string {
+		if index < 0 {
+			return strings.Repeat(" ", width+2)
+		}
+		return fmt.Sprintf("[%*d]", width, index)
+	}
+
+	var sb strings.Builder
+	skipped := false
+
+	for i, e := range script {
+		if !changed[i] {
+			skipped = true
+			continue
+		}
+
+		if skipped {
+			sb.WriteString("...\n")
+		}
+		skipped = false
+
+		switch e.kind {
+		case editEqual:
+			fmt.Fprintf(&sb, "  %s %s %s\n", column(e.aIndex), column(e.bIndex), format(a[e.aIndex]))
+		case editDelete:
+			fmt.Fprintf(&sb, "- %s %s %s\n", column(e.aIndex), column(-1), format(a[e.aIndex]))
+		case editInsert:
+			fmt.Fprintf(&sb, "+ %s %s %s\n", column(-1), column(e.bIndex), format(b[e.bIndex]))
+		}
+	}
+
+	if skipped {
+		sb.WriteString("...\n")
+	}
+
+	return sb.String()
+}
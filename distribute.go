@@ -0,0 +1,123 @@
+package arrays
+
+import "sort"
+
+/*
+Distribute deals the elements of the provided slice into n groups in round-robin
+order: element i goes to group i%n. Unlike a contiguous split, every group gets a
+mix of elements from the whole slice.
+
+Parameters:
+  - slice: The slice to distribute.
+  - n: The number of groups. If it is not positive, nil is returned.
+
+Returns:
+  - n new slices whose lengths differ by at most one. Elements keep their relative
+    order within each group.
+*/
+func Distribute[V any](slice []V, n int) [][]V {
+	if n <= 0 {
+		return nil
+	}
+
+	groups := make([][]V, n)
+	for g := range groups {
+		groups[g] = make([]V, 0, (len(slice)+n-1-g)/n)
+	}
+
+	for i, v := range slice {
+		groups[i%n] = append(groups[i%n], v)
+	}
+
+	return groups
+}
+
+/*
+DistributeByWeight assigns each element of the provided slice, in order, to the
+group with the smallest total weight so far, so that heavy and light elements are
+spread evenly across n groups.
+
+Elements keep their relative order within each group.
+
+Parameters:
+  - slice: The slice to distribute.
+  - n: The number of groups. If it is not positive, nil is returned.
+  - weight: A function that takes a value and returns its weight.
+
+Returns:
+  - n new slices. Ties between equally loaded groups go to the lowest group index.
+*/
+func DistributeByWeight[V any](slice []V, n int, weight func(value V) int) [][]V {
+	if n <= 0 {
+		return nil
+	}
+
+	groups := make([][]V, n)
+	loads := make([]int, n)
+
+	for _, v := range slice {
+		lightest := 0
+		for g := 1; g < n; g++ {
+			if loads[g] < loads[lightest] {
+				lightest = g
+			}
+		}
+
+		groups[lightest] = append(groups[lightest], v)
+		loads[lightest] += weight(v)
+	}
+
+	return groups
+}
+
+/*
+PartitionByWeight splits the elements of the provided slice into k partitions with
+total weights as even as possible, using the greedy longest-processing-time
+heuristic: elements are considered from heaviest to lightest, and each goes to the
+partition with the smallest total weight so far. The largest partition weight is
+guaranteed to be within 4/3 of the optimum.
+
+Parameters:
+  - slice: The slice to partition.
+  - k: The number of partitions. If it is not positive, nil is returned.
+  - weight: A function that takes a value and returns its weight.
+
+Returns:
+  - k new slices. Elements keep their relative order within each partition.
+*/
+func PartitionByWeight[V any](slice []V, k int, weight func(value V) int) [][]V {
+	if k <= 0 {
+		return nil
+	}
+
+	weights := make([]int, len(slice))
+	order := make([]int, len(slice))
+	for i, v := range slice {
+		weights[i] = weight(v)
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(a, b int) bool { return weights[order[a]] > weights[order[b]] })
+
+	owner := make([]int, len(slice))
+	loads := make([]int, k)
+
+	for _, i := range order {
+		lightest := 0
+		for p := 1; p < k; p++ {
+			if loads[p] < loads[lightest] {
+				lightest = p
+			}
+		}
+
+		owner[i] = lightest
+		loads[lightest] += weights[i]
+	}
+
+	partitions := make([][]V, k)
+	for i, v := range slice {
+		partitions[owner[i]] = append(partitions[owner[i]], v)
+	}
+
+	return partitions
+}
@@ -0,0 +1,62 @@
+package arrays
+
+/*
+LabelEncode replaces every element of the provided slice with an integer code
+identifying its category.
+
+Categories are numbered in order of first appearance, so the encoding is
+deterministic for a given input.
+
+Parameters:
+  - slice: The categorical values to encode.
+
+Returns:
+  - The code of every element, aligned with the input.
+  - The distinct categories, where categories[code] is the category of that code.
+*/
+func LabelEncode[V comparable](slice []V) ([]int, []V) {
+	codes := make([]int, len(slice))
+	positions := make(map[V]int)
+	var categories []V
+
+	for i, v := range slice {
+		code, ok := positions[v]
+		if !ok {
+			code = len(categories)
+			positions[v] = code
+			categories = append(categories, v)
+		}
+
+		codes[i] = code
+	}
+
+	return codes, categories
+}
+
+/*
+OneHot encodes every element of the provided slice as a row with a 1 in the column
+of its category and 0 elsewhere.
+
+Columns follow the order of LabelEncode, that is, the order of first appearance.
+
+Parameters:
+  - slice: The categorical values to encode.
+
+Returns:
+  - A matrix with one row per element and one column per category.
+  - The distinct categories, where categories[j] is the category of column j.
+*/
+func OneHot[V comparable](slice []V) ([][]int, []V) {
+	codes, categories := LabelEncode(slice)
+
+	cells := make([]int, len(slice)*len(categories))
+	matrix := make([][]int, len(slice))
+
+	for i, code := range codes {
+		row := cells[i*len(categories) : (i+1)*len(categories) : (i+1)*len(categories)]
+		row[code] = 1
+		matrix[i] = row
+	}
+
+	return matrix, categories
+}
@@ -0,0 +1,117 @@
+package arrays
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+/*
+ErrIndexOutOfRange is returned when an index does not refer to a valid position of
+a slice.
+*/
+var ErrIndexOutOfRange = errors.New("arrays: index out of range")
+
+/*
+IndexError records an error returned for the element at a specific index.
+
+Fields:
+  - Index: The index of the element that failed.
+  - Err: The error returned for that element.
+*/
+type IndexError struct {
+	Index int
+	Err   error
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("index %d: %v", e.Index, e.Err)
+}
+
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
+
+/*
+ErrLengthMismatch is returned when a slice does not have the length an operation
+requires.
+*/
+var ErrLengthMismatch = errors.New("arrays: length mismatch")
+
+/*
+ErrCycle is matched, with errors.Is, by the *CycleError returned when dependencies
+form a cycle.
+*/
+var ErrCycle = errors.New("arrays: dependency cycle")
+
+/*
+CycleError reports a dependency cycle.
+
+Fields:
+  - Cycle: The keys on the cycle, each depending on the next, with the first key
+    repeated at the end.
+*/
+type CycleError[K comparable] struct {
+	Cycle []K
+}
+
+func (e *CycleError[K]) Error() string {
+	var b strings.Builder
+	b.WriteString(ErrCycle.Error())
+	b.WriteString(": ")
+
+	for i, k := range e.Cycle {
+		if i > 0 {
+			b.WriteString(" -> ")
+		}
+		fmt.Fprint(&b, k)
+	}
+
+	return b.String()
+}
+
+func (e *CycleError[K]) Unwrap() error {
+	return ErrCycle
+}
+
+/*
+ErrDuplicateKey is returned when a key that must be unique appears more than once.
+*/
+var ErrDuplicateKey = errors.New("arrays: duplicate key")
+
+/*
+ErrUnknownKey is returned when an element refers to a key that no element has.
+*/
+var ErrUnknownKey = errors.New("arrays: unknown key")
+
+/*
+ErrNotPermutation is returned when an index slice is not a permutation of the
+indexes of the slice it applies to.
+*/
+var ErrNotPermutation = errors.New("arrays: not a permutation")
+
+/*
+PanicError reports a panic recovered from a callback.
+
+Fields:
+  - Value: The value passed to panic.
+  - Stack: The stack trace of the panicking goroutine, as formatted by
+    runtime/debug.Stack.
+*/
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("arrays: panic: %v", e.Value)
+}
+
+/*
+Unwrap returns the panic value if it is an error, such as a runtime error, and nil
+otherwise.
+*/
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
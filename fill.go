@@ -0,0 +1,57 @@
+package arrays
+
+/*
+FillForward returns a copy of the provided slice in which every missing element is
+replaced with the nearest valid element before it. Missing elements at the start,
+which have no valid element before them, are left as they are.
+
+Parameters:
+  - slice: The slice to fill.
+  - isMissing: A function that takes a value and returns true if it is missing.
+
+Returns:
+  - A new slice of the same length as the input.
+*/
+func FillForward[V any](slice []V, isMissing func(value V) bool) []V {
+	result := make([]V, len(slice))
+	copy(result, slice)
+
+	last := -1
+	for i, v := range result {
+		if !isMissing(v) {
+			last = i
+		} else if last >= 0 {
+			result[i] = result[last]
+		}
+	}
+
+	return result
+}
+
+/*
+FillBackward returns a copy of the provided slice in which every missing element is
+replaced with the nearest valid element after it. Missing elements at the end,
+which have no valid element after them, are left as they are.
+
+Parameters:
+  - slice: The slice to fill.
+  - isMissing: A function that takes a value and returns true if it is missing.
+
+Returns:
+  - A new slice of the same length as the input.
+*/
+func FillBackward[V any](slice []V, isMissing func(value V) bool) []V {
+	result := make([]V, len(slice))
+	copy(result, slice)
+
+	next := -1
+	for i := len(result) - 1; i >= 0; i-- {
+		if !isMissing(result[i]) {
+			next = i
+		} else if next >= 0 {
+			result[i] = result[next]
+		}
+	}
+
+	return result
+}
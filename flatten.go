@@ -0,0 +1,44 @@
+package arrays
+
+/*
+Flatten concatenates the provided slices into a single new slice, allocated once
+with the exact total length.
+
+Parameters:
+  - slices: The slices to concatenate.
+
+Returns:
+  - A new slice with the elements of every slice, in order.
+*/
+func Flatten[V any](slices [][]V) []V {
+	total := 0
+	for _, s := range slices {
+		total += len(s)
+	}
+
+	result := make([]V, 0, total)
+	for _, s := range slices {
+		result = append(result, s...)
+	}
+
+	return result
+}
+
+/*
+FlatMap applies the specified transform function to each element of the provided
+slice, and concatenates the resulting slices into a single new slice.
+
+The results of the transform are gathered first, so that the final slice is
+allocated once with the exact total length.
+
+Parameters:
+  - transform: A function that takes an index and a value, and returns a slice of
+    transformed values.
+  - slice: The slice to transform.
+
+Returns:
+  - A new slice with the transformed values of every element, in order.
+*/
+func FlatMap[V, R any](transform func(index int, value V) []R, slice []V) []R {
+	return Flatten(Map(transform, slice))
+}
@@ -0,0 +1,119 @@
+/*
+Package gen provides reproducible generators of slices for benchmarks and
+property-style tests.
+
+Every random generator takes a rand.Source, so that seeding it with a fixed value
+produces the same slices on every run.
+*/
+package gen
+
+import "math/rand"
+
+/*
+Generate returns a new slice of length n whose elements are produced by calling fn
+with each index in turn.
+
+Parameters:
+  - n: The length of the slice. Non-positive values produce an empty slice.
+  - fn: A function that takes an index and returns the element at that index.
+
+Returns:
+  - A new slice of length n.
+*/
+func Generate[V any](n int, fn func(index int) V) []V {
+	if n < 0 {
+		n = 0
+	}
+
+	result := make([]V, n)
+
+	for i := range result {
+		result[i] = fn(i)
+	}
+
+	return result
+}
+
+/*
+RandomInts returns a new slice of n integers drawn uniformly from [lo, hi).
+
+Parameters:
+  - src: The source of randomness.
+  - n: The length of the slice.
+  - lo: The inclusive lower bound.
+  - hi: The exclusive upper bound. If hi is not greater than lo, every element is lo.
+
+Returns:
+  - A new slice of length n.
+*/
+func RandomInts(src rand.Source, n, lo, hi int) []int {
+	r := rand.New(src)
+
+	return Generate(n, func(int) int {
+		if hi <= lo {
+			return lo
+		}
+
+		return lo + r.Intn(hi-lo)
+	})
+}
+
+/*
+RandomFloats returns a new slice of n floats drawn uniformly from [lo, hi).
+
+Parameters:
+  - src: The source of randomness.
+  - n: The length of the slice.
+  - lo: The inclusive lower bound.
+  - hi: The exclusive upper bound.
+
+Returns:
+  - A new slice of length n.
+*/
+func RandomFloats(src rand.Source, n int, lo, hi float64) []float64 {
+	r := rand.New(src)
+
+	return Generate(n, func(int) float64 {
+		return lo + r.Float64()*(hi-lo)
+	})
+}
+
+/*
+RandomStrings returns a new slice of n strings built from the characters of the
+provided alphabet, with lengths drawn uniformly from [minLen, maxLen].
+
+Parameters:
+  - src: The source of randomness.
+  - n: The length of the slice.
+  - alphabet: The characters to draw from. An empty alphabet produces empty strings.
+  - minLen: The inclusive minimum length of each string, in characters.
+  - maxLen: The inclusive maximum length of each string, in characters. If maxLen is
+    less than minLen, every string has length minLen.
+
+Returns:
+  - A new slice of length n.
+*/
+func RandomStrings(src rand.Source, n int, alphabet string, minLen, maxLen int) []string {
+	r := rand.New(src)
+	chars := []rune(alphabet)
+
+	if minLen < 0 {
+		minLen = 0
+	}
+	if maxLen < minLen {
+		maxLen = minLen
+	}
+
+	return Generate(n, func(int) string {
+		if len(chars) == 0 {
+			return ""
+		}
+
+		buf := make([]rune, minLen+r.Intn(maxLen-minLen+1))
+		for i := range buf {
+			buf[i] = chars[r.Intn(len(chars))]
+		}
+
+		return string(buf)
+	})
+}
@@ -0,0 +1,191 @@
+package arrays
+
+import "container/heap"
+
+/*
+TopoSort orders the elements of the provided slice so that every element comes after
+the elements it depends on.
+
+The sort is stable: among the elements whose dependencies are all satisfied, the one
+that comes first in the input is emitted first, so an input that is already in a
+valid order is returned unchanged. Dependencies on keys that no element has are
+ignored. Keys are expected to be unique.
+
+Parameters:
+  - slice: The elements to order.
+  - id: A function that takes a value and returns its key.
+  - deps: A function that takes a value and returns the keys it depends on.
+
+Returns:
+  - A new slice in dependency order, or nil on error.
+  - nil, or a *CycleError naming one of the cycles if the dependencies are circular.
+*/
+func TopoSort[V any, K comparable](slice []V, id func(value V) K, deps func(value V) []K) ([]V, error) {
+	g := newKeyedGraph(slice, id, deps)
+
+	indegree := make([]int, len(slice))
+	dependents := make([][]int, len(slice))
+	for i, ds := range g.deps {
+		indegree[i] = len(ds)
+		for _, j := range ds {
+			dependents[j] = append(dependents[j], i)
+		}
+	}
+
+	ready := &indexHeap{}
+	for i, d := range indegree {
+		if d == 0 {
+			heap.Push(ready, i)
+		}
+	}
+
+	result := make([]V, 0, len(slice))
+	for ready.Len() > 0 {
+		i := heap.Pop(ready).(int)
+		result = append(result, slice[i])
+
+		for _, j := range dependents[i] {
+			indegree[j]--
+			if indegree[j] == 0 {
+				heap.Push(ready, j)
+			}
+		}
+	}
+
+	if len(result) == len(slice) {
+		return result, nil
+	}
+
+	return nil, &CycleError[K]{Cycle: g.cycle(indegree)}
+}
+
+// keyedGraph is the dependency graph of a slice, with dependencies resolved from
+// keys to element indexes.
+type keyedGraph[K comparable] struct {
+	keys  []K
+	index map[K]int
+	deps  [][]int
+}
+
+func newKeyedGraph[V any, K comparable](slice []V, id func(value V) K, deps func(value V) []K) keyedGraph[K] {
+	g := keyedGraph[K]{
+		keys:  make([]K, len(slice)),
+		index: make(map[K]int, len(slice)),
+		deps:  make([][]int, len(slice)),
+	}
+
+	for i, v := range slice {
+		g.keys[i] = id(v)
+		if _, ok := g.index[g.keys[i]]; !ok {
+			g.index[g.keys[i]] = i
+		}
+	}
+
+	for i, v := range slice {
+		for _, k := range deps(v) {
+			if j, ok := g.index[k]; ok {
+				g.deps[i] = append(g.deps[i], j)
+			}
+		}
+	}
+
+	return g
+}
+
+// cycle walks the dependencies of the nodes that are still blocked, which all have
+// at least one blocked dependency, until a node repeats, and returns the keys of
+// the loop that was found.
+func (g keyedGraph[K]) cycle(indegree []int) []K {
+	start := 0
+	for indegree[start] == 0 {
+		start++
+	}
+
+	visited := make(map[int]int)
+	var path []int
+
+	for i := start; ; {
+		if at, ok := visited[i]; ok {
+			loop := append(path[at:], i)
+
+			keys := make([]K, len(loop))
+			for n, j := range loop {
+				keys[n] = g.keys[j]
+			}
+
+			return keys
+		}
+
+		visited[i] = len(path)
+		path = append(path, i)
+
+		for _, j := range g.deps[i] {
+			if indegree[j] > 0 {
+				i = j
+				break
+			}
+		}
+	}
+}
+
+// indexHeap is a min-heap of slice indexes.
+type indexHeap []int
+
+func (h indexHeap) Len() int           { return len(h) }
+func (h indexHeap) Less(i, j int) bool { return h[i] < h[j] }
+func (h indexHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *indexHeap) Push(x any)        { *h = append(*h, x.(int)) }
+
+func (h *indexHeap) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+/*
+Reachable returns the elements that can be reached from the element with the start
+key by following dependencies transitively, such as everything a task needs or,
+with reversed dependencies, everything impacted by a change.
+
+The start element itself is only included if it is reachable from one of its own
+dependencies, that is, if it lies on a cycle. Dependencies on keys that no element
+has are ignored.
+
+Parameters:
+  - slice: The elements of the graph.
+  - id: A function that takes a value and returns its key.
+  - deps: A function that takes a value and returns the keys it depends on.
+  - start: The key to start from.
+
+Returns:
+  - A new slice with the reachable elements in breadth-first order, or nil if no
+    element has the start key.
+*/
+func Reachable[V any, K comparable](slice []V, id func(value V) K, deps func(value V) []K, start K) []V {
+	g := newKeyedGraph(slice, id, deps)
+
+	from, ok := g.index[start]
+	if !ok {
+		return nil
+	}
+
+	visited := make([]bool, len(slice))
+	queue := []int{from}
+	var result []V
+
+	for len(queue) > 0 {
+		i := queue[0]
+		queue = queue[1:]
+
+		for _, j := range g.deps[i] {
+			if !visited[j] {
+				visited[j] = true
+				result = append(result, slice[j])
+				queue = append(queue, j)
+			}
+		}
+	}
+
+	return result
+}
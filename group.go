@@ -0,0 +1,140 @@
+package arrays
+
+/*
+Group is a key together with the elements that were grouped under it.
+
+Fields:
+  - Key: The key shared by every element of the group.
+  - Items: The elements belonging to the group, in their original order.
+*/
+type Group[K comparable, V any] struct {
+	Key   K
+	Items []V
+}
+
+/*
+GroupBy groups the elements of the provided slice by the key returned by the
+specified function.
+
+Parameters:
+  - keyFn: A function that takes an index and a value, and returns the key of the
+    value.
+  - slice: The slice to group.
+
+Returns:
+  - A map from every key to the elements with that key, in their original order.
+*/
+func GroupBy[V any, K comparable](keyFn func(index int, value V) K, slice []V) map[K][]V {
+	result := make(map[K][]V)
+
+	for i, v := range slice {
+		k := keyFn(i, v)
+		result[k] = append(result[k], v)
+	}
+
+	return result
+}
+
+/*
+GroupByKey groups the elements of the provided slice like GroupBy, but also
+transforms every element while grouping, so that the groups hold only what is
+needed (for example one field of each record).
+
+Parameters:
+  - keyValue: A function that takes an index and a value, and returns the key of the
+    value and the transformed value to store in its group.
+  - slice: The slice to group.
+
+Returns:
+  - A map from every key to the transformed elements with that key, in their
+    original order.
+*/
+func GroupByKey[V any, K comparable, R any](keyValue func(index int, value V) (K, R), slice []V) map[K][]R {
+	result := make(map[K][]R)
+
+	for i, v := range slice {
+		k, r := keyValue(i, v)
+		result[k] = append(result[k], r)
+	}
+
+	return result
+}
+
+/*
+GroupBySorted groups consecutive elements of the provided slice that share the same
+key, in a single pass and without allocating a map.
+
+The slice is expected to be sorted (or at least clustered) by key. Elements with the
+same key that are not adjacent end up in separate groups. The Items of each group
+are subslices of the input, so no elements are copied; their capacity is clipped so
+that appending to a group never overwrites its neighbour.
+
+Parameters:
+  - key: A function that takes an index and a value, and returns the key of the value.
+  - slice: The sorted slice to group.
+
+Returns:
+  - The groups in the order their keys appear in the slice.
+*/
+func GroupBySorted[V any, K comparable](key func(index int, value V) K, slice []V) []Group[K, V] {
+	var result []Group[K, V]
+
+	start := 0
+	for i := 0; i < len(slice); i++ {
+		k := key(i, slice[i])
+
+		if len(result) > 0 && result[len(result)-1].Key == k {
+			continue
+		}
+
+		if len(result) > 0 {
+			result[len(result)-1].Items = slice[start:i:i]
+		}
+
+		result = append(result, Group[K, V]{Key: k})
+		start = i
+	}
+
+	if len(result) > 0 {
+		result[len(result)-1].Items = slice[start:len(slice):len(slice)]
+	}
+
+	return result
+}
+
+/*
+ReduceBy reduces the elements of the provided slice separately for each key, in a
+single pass and without materializing the groups.
+
+Parameters:
+  - key: A function that takes a value and returns its key.
+  - reducer: A function that takes the accumulator of a key and a value with that
+    key, and returns the new accumulator.
+  - initial: A function that takes a key seen for the first time and returns the
+    initial accumulator for it.
+  - slice: The slice to reduce.
+
+Returns:
+  - A map from every key present in the slice to its final accumulator.
+*/
+func ReduceBy[V any, K comparable, A any](
+	key func(value V) K,
+	reducer func(accumulator A, value V) A,
+	initial func(key K) A,
+	slice []V,
+) map[K]A {
+	result := make(map[K]A)
+
+	for _, v := range slice {
+		k := key(v)
+
+		acc, ok := result[k]
+		if !ok {
+			acc = initial(k)
+		}
+
+		result[k] = reducer(acc, v)
+	}
+
+	return result
+}
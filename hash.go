@@ -0,0 +1,236 @@
+package arrays
+
+import (
+	"math"
+	"reflect"
+)
+
+/*
+HashSlice returns a 64-bit hash of the elements of the provided slice, in order.
+
+The hash is deterministic: the same elements and seed produce the same hash in
+every run and on every platform, so it can be used as a cache key. It is built on
+FNV-1a and is not cryptographic. Elements are encoded by value, field by field for
+structs and arrays; pointers and channels are hashed by address, which is only
+stable within a process.
+
+Parameters:
+  - slice: The slice to hash.
+  - seed: A value mixed into the hash, to derive independent hash families.
+
+Returns:
+  - The hash. Reordering the elements changes it.
+*/
+func HashSlice[V comparable](slice []V, seed uint64) uint64 {
+	h := newSliceHasher(seed)
+
+	for _, v := range slice {
+		writeValue(&h, v)
+	}
+
+	return h.Sum64()
+}
+
+/*
+HashUnordered returns a 64-bit hash of the elements of the provided slice that does
+not depend on their order, so that any permutation of the same elements (with the
+same multiplicities) hashes the same.
+
+Elements are encoded as in HashSlice, and the hash is deterministic in the same way.
+
+Parameters:
+  - slice: The slice to hash.
+  - seed: A value mixed into the hash, to derive independent hash families.
+
+Returns:
+  - The hash.
+*/
+func HashUnordered[V comparable](slice []V, seed uint64) uint64 {
+	var (
+		sum uint64
+		h   sliceHasher
+	)
+
+	for _, v := range slice {
+		h.reset(seed)
+		writeValue(&h, v)
+		sum += mix64(h.Sum64())
+	}
+
+	return mix64(sum ^ mix64(uint64(len(slice))+seed))
+}
+
+/*
+HashBy returns a 64-bit hash of the provided slice, in order, using a caller-supplied
+hash for each element. It works for element types that are not comparable, or whose
+identity is defined by only some of their fields.
+
+Parameters:
+  - slice: The slice to hash.
+  - seed: A value mixed into the hash, to derive independent hash families.
+  - hash: A function that takes a value and returns its hash.
+
+Returns:
+  - The hash. Reordering the elements changes it.
+*/
+func HashBy[V any](slice []V, seed uint64, hash func(value V) uint64) uint64 {
+	h := newSliceHasher(seed)
+
+	for _, v := range slice {
+		h.writeUint64(hash(v))
+	}
+
+	return h.Sum64()
+}
+
+/*
+SortByHash returns a copy of the provided slice ordered by a seeded hash of each
+element's key. The order looks random and is uncorrelated with the input order, but
+is the same in every run for the same keys and seed, which makes it suitable for
+fair but reproducible rotation, such as spreading work across backends.
+
+Keys are hashed as in HashSlice. Elements whose keys hash the same, including
+elements with equal keys, keep their original order.
+
+Parameters:
+  - slice: The slice to order. It is not modified.
+  - seed: A value mixed into the hash; different seeds give independent orders.
+  - key: A function that takes a value and returns the key to hash.
+
+Returns:
+  - A new slice with the elements in hash order.
+*/
+func SortByHash[V any, K comparable](slice []V, seed uint64, key func(value V) K) []V {
+	hashes := make([]uint64, len(slice))
+
+	var h sliceHasher
+	for i, v := range slice {
+		h.reset(seed)
+		writeValue(&h, key(v))
+		hashes[i] = h.Sum64()
+	}
+
+	order := SortIndex(hashes, func(a, b uint64) bool { return a < b })
+
+	result := make([]V, len(slice))
+	for i, j := range order {
+		result[i] = slice[j]
+	}
+
+	return result
+}
+
+// sliceHasher is an FNV-1a hash that lives on the stack and writes integers and
+// strings without allocating. reset starts it over, so one hasher can serve every
+// element of a slice.
+type sliceHasher struct {
+	sum uint64
+}
+
+const (
+	fnvOffset64 = 14695981039346656037
+	fnvPrime64  = 1099511628211
+)
+
+func newSliceHasher(seed uint64) sliceHasher {
+	var h sliceHasher
+	h.reset(seed)
+
+	return h
+}
+
+func (h *sliceHasher) reset(seed uint64) {
+	h.sum = fnvOffset64
+	h.writeUint64(seed)
+}
+
+func (h *sliceHasher) Sum64() uint64 {
+	return h.sum
+}
+
+func (h *sliceHasher) writeUint64(x uint64) {
+	for i := 0; i < 8; i++ {
+		h.sum ^= x & 0xff
+		h.sum *= fnvPrime64
+		x >>= 8
+	}
+}
+
+func (h *sliceHasher) writeString(s string) {
+	h.writeUint64(uint64(len(s)))
+
+	for i := 0; i < len(s); i++ {
+		h.sum ^= uint64(s[i])
+		h.sum *= fnvPrime64
+	}
+}
+
+// writeValue encodes a comparable value, with fast paths for the most common
+// element types that avoid boxing the value.
+func writeValue[V any](h *sliceHasher, v V) {
+	switch x := any(v).(type) {
+	case string:
+		h.writeString(x)
+	case int:
+		h.writeUint64(uint64(x))
+	case int64:
+		h.writeUint64(uint64(x))
+	case uint64:
+		h.writeUint64(x)
+	default:
+		h.writeReflect(reflect.ValueOf(any(v)))
+	}
+}
+
+func (h *sliceHasher) writeReflect(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Invalid:
+		h.writeUint64(0)
+	case reflect.Bool:
+		if v.Bool() {
+			h.writeUint64(1)
+		} else {
+			h.writeUint64(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		h.writeUint64(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		h.writeUint64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		h.writeUint64(math.Float64bits(v.Float()))
+	case reflect.Complex64, reflect.Complex128:
+		h.writeUint64(math.Float64bits(real(v.Complex())))
+		h.writeUint64(math.Float64bits(imag(v.Complex())))
+	case reflect.String:
+		h.writeString(v.String())
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			h.writeReflect(v.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			h.writeReflect(v.Field(i))
+		}
+	case reflect.Interface:
+		if v.IsNil() {
+			h.writeUint64(0)
+			return
+		}
+		h.writeString(v.Elem().Type().String())
+		h.writeReflect(v.Elem())
+	case reflect.Pointer, reflect.Chan, reflect.UnsafePointer:
+		h.writeUint64(uint64(v.Pointer()))
+	}
+}
+
+// mix64 is the SplitMix64 finalizer, used to spread element hashes before they are
+// combined by addition.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+
+	return x
+}
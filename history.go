@@ -0,0 +1,125 @@
+package arrays
+
+/*
+History wraps a slice and records the ops applied to it, so that they can be undone
+and redone.
+
+A History must be created with NewHistory, and is not safe for concurrent use.
+*/
+type History[V any] struct {
+	slice []V
+	depth int
+	undo  []historyEntry[V]
+	redo  []historyEntry[V]
+}
+
+type historyEntry[V any] struct {
+	op      Op[V]
+	inverse Op[V]
+}
+
+/*
+NewHistory creates a history over a copy of the provided slice.
+
+Parameters:
+  - slice: The initial contents. The slice itself is never modified.
+  - depth: The maximum number of ops that can be undone. Older ops are forgotten.
+    Non-positive values mean the history is unbounded.
+
+Returns:
+  - A new history with nothing to undo or redo.
+*/
+func NewHistory[V any](slice []V, depth int) *History[V] {
+	s := make([]V, len(slice))
+	copy(s, slice)
+
+	return &History[V]{slice: s, depth: depth}
+}
+
+/*
+Value returns the current contents. The returned slice is owned by the history and
+must not be modified; it is only valid until the next call to Apply, Undo, or Redo.
+*/
+func (h *History[V]) Value() []V {
+	return h.slice
+}
+
+/*
+Apply applies an op to the current contents and records it. Applying an op clears
+everything that could have been redone.
+
+Parameters:
+  - op: The op to apply.
+
+Returns:
+  - An error wrapping ErrIndexOutOfRange if the op does not fit the current
+    contents, in which case nothing changes.
+*/
+func (h *History[V]) Apply(op Op[V]) error {
+	slice, inverse, err := applyOp(h.slice, op)
+	if err != nil {
+		return err
+	}
+
+	h.slice = slice
+	h.undo = append(h.undo, historyEntry[V]{op: op, inverse: inverse})
+	h.redo = h.redo[:0]
+
+	if h.depth > 0 && len(h.undo) > h.depth {
+		h.undo = append(h.undo[:0], h.undo[len(h.undo)-h.depth:]...)
+	}
+
+	return nil
+}
+
+/*
+Undo reverts the most recently applied op that has not been undone yet.
+
+Returns:
+  - true if an op was undone, false if there was nothing to undo.
+*/
+func (h *History[V]) Undo() bool {
+	if len(h.undo) == 0 {
+		return false
+	}
+
+	entry := h.undo[len(h.undo)-1]
+	h.undo = h.undo[:len(h.undo)-1]
+	h.slice, _, _ = applyOp(h.slice, entry.inverse)
+	h.redo = append(h.redo, entry)
+
+	return true
+}
+
+/*
+Redo re-applies the most recently undone op.
+
+Returns:
+  - true if an op was redone, false if there was nothing to redo.
+*/
+func (h *History[V]) Redo() bool {
+	if len(h.redo) == 0 {
+		return false
+	}
+
+	entry := h.redo[len(h.redo)-1]
+	h.redo = h.redo[:len(h.redo)-1]
+	h.slice, _, _ = applyOp(h.slice, entry.op)
+	h.undo = append(h.undo, entry)
+
+	return true
+}
+
+/*
+CanUndo reports whether there is an op to undo.
+*/
+func (h *History[V]) CanUndo() bool {
+	return len(h.undo) > 0
+}
+
+/*
+CanRedo reports whether there is an op to redo.
+*/
+func (h *History[V]) CanRedo() bool {
+	return len(h.redo) > 0
+}
@@ -0,0 +1,65 @@
+package arrays
+
+import "strings"
+
+/*
+Intern returns a new slice in which equal strings share the same backing memory.
+
+The first occurrence of each distinct string is cloned, so the interned strings do
+not keep alive any larger buffer the originals were sliced from (such as a line read
+from a file). Once the input slice is dropped, only one copy of each distinct value
+remains.
+
+Parameters:
+  - slice: The strings to intern.
+
+Returns:
+  - A new slice with the same values as the input.
+*/
+func Intern(slice []string) []string {
+	canonical := make(map[string]string)
+	result := make([]string, len(slice))
+
+	for i, s := range slice {
+		c, ok := canonical[s]
+		if !ok {
+			c = strings.Clone(s)
+			canonical[c] = c
+		}
+
+		result[i] = c
+	}
+
+	return result
+}
+
+/*
+InternBy returns a new slice in which every element is replaced by the first element
+of the provided slice that has the same canonical key, so that equivalent values
+share the same instance (and whatever memory it references).
+
+Parameters:
+  - key: A function that takes a value and returns its canonical key.
+  - slice: The values to intern.
+
+Returns:
+  - A new slice of the same length as the input.
+*/
+func InternBy[V any, K comparable](key func(value V) K, slice []V) []V {
+	canonical := make(map[K]V)
+	result := make([]V, len(slice))
+
+	for i, v := range slice {
+		k := key(v)
+
+		c, ok := canonical[k]
+		if !ok {
+			c = v
+			canonical[k] = c
+		}
+
+		result[i] = c
+	}
+
+	return result
+}
@@ -0,0 +1,100 @@
+package arrays
+
+import (
+	"cmp"
+	"sort"
+)
+
+/*
+MergeIntervals coalesces intervals that overlap or touch into single intervals, as
+when combining busy slots in a calendar or adjacent IP ranges.
+
+Intervals are half-open, [start, end), and two of them are coalesced when the
+second starts no later than the first ends. The input does not need to be sorted.
+
+Parameters:
+  - slice: The intervals.
+  - start: A function that takes an interval and returns its start.
+  - end: A function that takes an interval and returns its end.
+  - merge: A function that takes two coalescing intervals, the first one starting no
+    later than the second, and returns the interval covering both. It decides how
+    the other fields of V are combined.
+
+Returns:
+  - A new slice of disjoint intervals, sorted by start.
+*/
+func MergeIntervals[V any, N cmp.Ordered](
+	slice []V,
+	start func(value V) N,
+	end func(value V) N,
+	merge func(a, b V) V,
+) []V {
+	sorted := make([]V, len(slice))
+	copy(sorted, slice)
+	sort.SliceStable(sorted, func(i, j int) bool { return start(sorted[i]) < start(sorted[j]) })
+
+	result := make([]V, 0, len(sorted))
+
+	for _, v := range sorted {
+		if n := len(result); n > 0 && start(v) <= end(result[n-1]) {
+			result[n-1] = merge(result[n-1], v)
+			continue
+		}
+
+		result = append(result, v)
+	}
+
+	return result
+}
+
+/*
+FindOverlaps returns every pair of intervals that overlap, as when detecting
+double bookings.
+
+Intervals are half-open, [start, end), so intervals that only touch do not overlap.
+The pairs are found with a sweep over the intervals sorted by start, in
+O(n log n + k) time for k overlapping pairs.
+
+Parameters:
+  - slice: The intervals.
+  - start: A function that takes an interval and returns its start.
+  - end: A function that takes an interval and returns its end.
+
+Returns:
+  - A new slice of overlapping pairs. In each pair, First starts no later than
+    Second, and pairs are ordered by the start of Second.
+*/
+func FindOverlaps[V any, N cmp.Ordered](slice []V, start func(value V) N, end func(value V) N) []Pair[V, V] {
+	order := make([]int, len(slice))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return start(slice[order[i]]) < start(slice[order[j]]) })
+
+	var (
+		result []Pair[V, V]
+		active []int
+	)
+
+	for _, i := range order {
+		s := start(slice[i])
+
+		kept := active[:0]
+		for _, j := range active {
+			if end(slice[j]) > s {
+				kept = append(kept, j)
+			}
+		}
+		active = kept
+
+		for _, j := range active {
+			result = append(result, Pair[V, V]{First: slice[j], Second: slice[i]})
+		}
+
+		if end(slice[i]) > s {
+			active = append(active, i)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,117 @@
+package arrays
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"iter"
+)
+
+/*
+DecodeJSONLines decodes every value of the provided JSON Lines (NDJSON) data.
+
+Parameters:
+  - r: The JSON Lines data.
+
+Returns:
+  - The decoded values, in order.
+  - The first decode error, annotated with the position of the failing value.
+*/
+func DecodeJSONLines[T any](r io.Reader) ([]T, error) {
+	var result []T
+
+	err := DecodeJSONLinesEach(r, func(value T) error {
+		result = append(result, value)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+/*
+DecodeJSONLinesEach decodes the values of the provided JSON Lines (NDJSON) data one
+by one and passes each of them to fn, without loading the whole input.
+
+Parameters:
+  - r: The JSON Lines data.
+  - fn: A function called with each decoded value. Returning an error stops
+    decoding.
+
+Returns:
+  - The first decode or fn error. Decode errors are annotated with the position of
+    the failing value.
+*/
+func DecodeJSONLinesEach[T any](r io.Reader, fn func(value T) error) error {
+	for value, err := range DecodeJSONLinesSeq[T](r) {
+		if err != nil {
+			return err
+		}
+
+		if err := fn(value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+/*
+DecodeJSONLinesSeq returns an iterator over the values of the provided JSON Lines
+(NDJSON) data, decoding them lazily as the iterator is consumed.
+
+Decoding stops at the first error, which is yielded together with a zero value.
+
+Parameters:
+  - r: The JSON Lines data.
+
+Returns:
+  - An iterator of decoded values and decode errors.
+*/
+func DecodeJSONLinesSeq[T any](r io.Reader) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		dec := json.NewDecoder(r)
+
+		for i := 0; ; i++ {
+			var value T
+
+			err := dec.Decode(&value)
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				var zero T
+				yield(zero, fmt.Errorf("arrays: JSON value %d: %w", i, err))
+				return
+			}
+
+			if !yield(value, nil) {
+				return
+			}
+		}
+	}
+}
+
+/*
+EncodeJSONLines encodes every element of the provided slice as a line of JSON.
+
+Parameters:
+  - w: The destination of the JSON Lines data.
+  - slice: The values to write.
+
+Returns:
+  - The first encode or write error, if any.
+*/
+func EncodeJSONLines[T any](w io.Writer, slice []T) error {
+	enc := json.NewEncoder(w)
+
+	for _, v := range slice {
+		if err := enc.Encode(v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,367 @@
+package arrays
+
+import "fmt"
+
+/*
+MergeBy merges the provided slices into a single slice, combining elements that
+share the same key.
+
+Elements are emitted in the order their keys are first seen. When a key is seen
+again, the resolve function decides which value is kept.
+
+Parameters:
+  - key: A function that takes a value and returns its key.
+  - resolve: A function that takes the value already stored for a key and the newly
+    encountered value with the same key, and returns the value to keep.
+  - slices: The slices to merge, in priority order.
+
+Returns:
+  - A new slice containing one element per distinct key.
+*/
+func MergeBy[V any, K comparable](
+	key func(value V) K,
+	resolve func(existing, incoming V) V,
+	slices ...[]V,
+) []V {
+	total := 0
+	for _, s := range slices {
+		total += len(s)
+	}
+
+	result := make([]V, 0, total)
+	positions := make(map[K]int, total)
+
+	for _, s := range slices {
+		for _, v := range s {
+			k := key(v)
+
+			if i, ok := positions[k]; ok {
+				result[i] = resolve(result[i], v)
+				continue
+			}
+
+			positions[k] = len(result)
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+/*
+UpsertBy replaces the first element of the provided slice whose key matches the key
+of item, or appends item if no such element exists.
+
+Like append, UpsertBy may modify the provided slice in place, so the result should
+be assigned back to the original variable.
+
+Parameters:
+  - slice: The slice to update.
+  - item: The value to insert or replace with.
+  - key: A function that takes a value and returns its key.
+
+Returns:
+  - The updated slice.
+*/
+func UpsertBy[V any, K comparable](slice []V, item V, key func(value V) K) []V {
+	k := key(item)
+
+	for i := range slice {
+		if key(slice[i]) == k {
+			slice[i] = item
+			return slice
+		}
+	}
+
+	return append(slice, item)
+}
+
+/*
+UpsertAllBy upserts every element of items into the provided slice, as if UpsertBy
+was called for each of them in order, but computes the key of each existing element
+only once.
+
+Like append, UpsertAllBy may modify the provided slice in place, so the result
+should be assigned back to the original variable.
+
+Parameters:
+  - slice: The slice to update.
+  - items: The values to insert or replace with.
+  - key: A function that takes a value and returns its key.
+
+Returns:
+  - The updated slice.
+*/
+func UpsertAllBy[V any, K comparable](slice []V, items []V, key func(value V) K) []V {
+	positions := make(map[K]int, len(slice)+len(items))
+
+	for i, v := range slice {
+		k := key(v)
+		if _, ok := positions[k]; !ok {
+			positions[k] = i
+		}
+	}
+
+	for _, item := range items {
+		k := key(item)
+
+		if i, ok := positions[k]; ok {
+			slice[i] = item
+			continue
+		}
+
+		positions[k] = len(slice)
+		slice = append(slice, item)
+	}
+
+	return slice
+}
+
+/*
+UniqueByLast returns a new slice containing only the last occurrence of each key in
+the provided slice (latest wins).
+
+The kept elements appear in the order of their last occurrence.
+
+Parameters:
+  - key: A function that takes a value and returns its key.
+  - slice: The slice to deduplicate.
+
+Returns:
+  - A new slice containing one element per distinct key.
+*/
+func UniqueByLast[V any, K comparable](key func(value V) K, slice []V) []V {
+	keys := make([]K, len(slice))
+	last := make(map[K]int, len(slice))
+
+	for i, v := range slice {
+		keys[i] = key(v)
+		last[keys[i]] = i
+	}
+
+	result := make([]V, 0, len(last))
+
+	for i, v := range slice {
+		if last[keys[i]] == i {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+/*
+Dedupe returns a new slice containing the first occurrence of each distinct element
+of the provided slice, in their original order.
+
+For a []byte, a 256-entry lookup table is used instead of a map. For a []rune, the
+same table covers ASCII, and a map is only allocated once another rune shows up.
+
+Parameters:
+  - slice: The slice to deduplicate.
+
+Returns:
+  - A new slice with the duplicates removed.
+*/
+func Dedupe[V comparable](slice []V) []V {
+	if b, ok := any(slice).([]byte); ok {
+		var seen [256]bool
+		result := make([]byte, 0, min(len(b), 256))
+
+		for _, c := range b {
+			if !seen[c] {
+				seen[c] = true
+				result = append(result, c)
+			}
+		}
+
+		return any(result).([]V)
+	}
+	if r, ok := any(slice).([]rune); ok {
+		return any(dedupeRunes(r)).([]V)
+	}
+
+	seen := make(map[V]struct{}, len(slice))
+	result := make([]V, 0, len(slice))
+
+	for _, v := range slice {
+		if _, ok := seen[v]; !ok {
+			seen[v] = struct{}{}
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+// dedupeRunes is Dedupe for runes, with a lookup table for ASCII.
+func dedupeRunes(s []rune) []rune {
+	var (
+		ascii [128]bool
+		other map[rune]struct{}
+	)
+	result := make([]rune, 0, len(s))
+
+	for _, r := range s {
+		if r >= 0 && r < 128 {
+			if ascii[r] {
+				continue
+			}
+			ascii[r] = true
+		} else {
+			if _, ok := other[r]; ok {
+				continue
+			}
+			if other == nil {
+				other = make(map[rune]struct{})
+			}
+			other[r] = struct{}{}
+		}
+
+		result = append(result, r)
+	}
+
+	return result
+}
+
+/*
+DistinctUntilChangedBy returns a new slice without the elements whose key equals the
+key of the element right before them, so that only the changes remain.
+
+Unlike Dedupe, a key may appear several times in the result, as long as the
+occurrences are not consecutive.
+
+Parameters:
+  - key: A function that takes a value and returns its key.
+  - slice: The slice to filter.
+
+Returns:
+  - A new slice with consecutive repeats removed. The first element is always kept.
+*/
+func DistinctUntilChangedBy[V any, K comparable](key func(value V) K, slice []V) []V {
+	result := make([]V, 0, len(slice))
+
+	var prev K
+	for i, v := range slice {
+		k := key(v)
+
+		if i == 0 || k != prev {
+			result = append(result, v)
+		}
+
+		prev = k
+	}
+
+	return result
+}
+
+/*
+Uniq returns a new slice containing the first occurrence of each distinct element of
+the provided slice, in their original order. When equal elements repeat, the first
+one wins and the later ones are dropped. It is the same operation as Dedupe.
+
+Parameters:
+  - slice: The slice to deduplicate.
+
+Returns:
+  - A new slice with the duplicates removed.
+*/
+func Uniq[V comparable](slice []V) []V {
+	return Dedupe(slice)
+}
+
+/*
+UniqBy returns a new slice containing, for each distinct key, the first element of
+the provided slice with that key, in their original order. When keys repeat, the
+first element wins; see UniqueByLast for the opposite policy.
+
+Parameters:
+  - keyFn: A function that takes a value and returns its key.
+  - slice: The slice to deduplicate.
+
+Returns:
+  - A new slice with one element per distinct key.
+*/
+func UniqBy[V any, K comparable](keyFn func(value V) K, slice []V) []V {
+	seen := make(map[K]struct{}, len(slice))
+	result := make([]V, 0, len(slice))
+
+	for _, v := range slice {
+		k := keyFn(v)
+
+		if _, ok := seen[k]; !ok {
+			seen[k] = struct{}{}
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+/*
+FilterNotIn returns the elements of the provided slice whose keys are not already
+present in an external store, such as a database, looking the keys up in batches.
+
+Each distinct key is looked up once, so duplicates in the input cost nothing extra;
+the duplicates themselves are all kept if their key is unseen. The lookups run one
+batch at a time, in input order.
+
+Parameters:
+  - slice: The slice to filter.
+  - existing: A function that takes a batch of distinct keys and returns the ones
+    that already exist, or an error. Keys missing from the map, or mapped to false,
+    are treated as unseen.
+  - key: A function that takes a value and returns its key.
+  - batchSize: The maximum number of keys per lookup. If it is not positive, all
+    keys are looked up at once.
+
+Returns:
+  - A new slice with the elements whose keys were not found, in order, or nil on
+    error.
+  - nil, or the first error returned by existing, annotated with its batch number.
+*/
+func FilterNotIn[V any, K comparable](
+	slice []V,
+	existing func(keys []K) (map[K]bool, error),
+	key func(value V) K,
+	batchSize int,
+) ([]V, error) {
+	keys := make([]K, len(slice))
+	distinct := make([]K, 0, len(slice))
+	found := make(map[K]bool, len(slice))
+
+	for i, v := range slice {
+		k := key(v)
+		keys[i] = k
+
+		if _, ok := found[k]; !ok {
+			found[k] = false
+			distinct = append(distinct, k)
+		}
+	}
+
+	if batchSize <= 0 {
+		batchSize = max(len(distinct), 1)
+	}
+
+	for i, batch := range Chunk(distinct, batchSize) {
+		seen, err := existing(batch)
+		if err != nil {
+			return nil, fmt.Errorf("arrays: batch %d: %w", i, err)
+		}
+
+		for _, k := range batch {
+			found[k] = seen[k]
+		}
+	}
+
+	result := make([]V, 0, len(slice))
+
+	for i, v := range slice {
+		if !found[keys[i]] {
+			result = append(result, v)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,125 @@
+package arrays
+
+/*
+Keys returns the keys of the provided map. Like ranging over the map, the order is
+unspecified and may differ between calls; sort the result if it matters.
+
+Parameters:
+  - m: The map to read from.
+
+Returns:
+  - A new slice with every key of the map.
+*/
+func Keys[K comparable, V any](m map[K]V) []K {
+	result := make([]K, 0, len(m))
+
+	for k := range m {
+		result = append(result, k)
+	}
+
+	return result
+}
+
+/*
+Values returns the values of the provided map, in unspecified order, as Keys.
+
+Parameters:
+  - m: The map to read from.
+
+Returns:
+  - A new slice with every value of the map.
+*/
+func Values[K comparable, V any](m map[K]V) []V {
+	result := make([]V, 0, len(m))
+
+	for _, v := range m {
+		result = append(result, v)
+	}
+
+	return result
+}
+
+/*
+Entries returns the key-value pairs of the provided map, in unspecified order, as
+Keys. It is the inverse of FromEntries.
+
+Parameters:
+  - m: The map to read from.
+
+Returns:
+  - A new slice with a pair of key and value for every entry of the map.
+*/
+func Entries[K comparable, V any](m map[K]V) []Pair[K, V] {
+	result := make([]Pair[K, V], 0, len(m))
+
+	for k, v := range m {
+		result = append(result, Pair[K, V]{First: k, Second: v})
+	}
+
+	return result
+}
+
+/*
+FromEntries builds a map from the provided key-value pairs. When several pairs
+share a key, the last one wins.
+
+Parameters:
+  - entries: The pairs of key and value.
+
+Returns:
+  - A new map with an entry for every distinct key.
+*/
+func FromEntries[K comparable, V any](entries []Pair[K, V]) map[K]V {
+	result := make(map[K]V, len(entries))
+
+	for _, e := range entries {
+		result[e.First] = e.Second
+	}
+
+	return result
+}
+
+/*
+MapValues returns a new map with the same keys as the provided map and every value
+replaced by the result of the specified transform function.
+
+Parameters:
+  - transform: A function that takes a key and a value, and returns the new value.
+  - m: The map to transform.
+
+Returns:
+  - A new map with the transformed values.
+*/
+func MapValues[K comparable, V, R any](transform func(key K, value V) R, m map[K]V) map[K]R {
+	result := make(map[K]R, len(m))
+
+	for k, v := range m {
+		result[k] = transform(k, v)
+	}
+
+	return result
+}
+
+/*
+FilterMapEntries returns a new map containing only the entries of the provided map
+for which the specified predicate function returns true.
+
+Parameters:
+  - predicate: A function that takes a key and a value, and returns true if the
+    entry should be included in the result map.
+  - m: The map to filter.
+
+Returns:
+  - A new map with the matching entries.
+*/
+func FilterMapEntries[K comparable, V any](predicate func(key K, value V) bool, m map[K]V) map[K]V {
+	result := make(map[K]V)
+
+	for k, v := range m {
+		if predicate(k, v) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,41 @@
+package arrays
+
+import "fmt"
+
+/*
+MaskOf evaluates the specified predicate function on each element of the provided
+slice and returns the results, so that the same mask can be applied to several
+parallel slices with SelectByMask.
+
+Parameters:
+  - predicate: A function that takes an index and a value, and returns true if the
+    value is selected.
+  - slice: The slice to evaluate.
+
+Returns:
+  - A new slice of booleans aligned with the input.
+*/
+func MaskOf[V any](predicate func(index int, value V) bool, slice []V) []bool {
+	return Map(predicate, slice)
+}
+
+/*
+SelectByMask returns a new slice with the elements of the provided slice whose
+position in the mask is true.
+
+Parameters:
+  - slice: The slice to select from.
+  - mask: One boolean per element of the slice.
+
+Returns:
+  - A new slice with the selected elements, in order, or nil on error.
+  - nil, or an error wrapping ErrLengthMismatch if the mask and the slice differ in
+    length.
+*/
+func SelectByMask[V any](slice []V, mask []bool) ([]V, error) {
+	if len(mask) != len(slice) {
+		return nil, fmt.Errorf("%w: mask of length %d for slice of length %d", ErrLengthMismatch, len(mask), len(slice))
+	}
+
+	return Filter(func(i int, _ V) bool { return mask[i] }, slice), nil
+}
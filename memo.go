@@ -0,0 +1,96 @@
+package arrays
+
+import (
+	"container/list"
+	"slices"
+	"sync"
+	"time"
+)
+
+/*
+MemoConfig configures MemoizeByHash. The zero value caches every result forever.
+
+Fields:
+  - MaxEntries: The maximum number of cached results. When it is exceeded, the
+    least recently used result is evicted. Non-positive values mean no limit.
+  - TTL: How long a result stays valid after it was computed. Non-positive values
+    mean results never expire.
+*/
+type MemoConfig struct {
+	MaxEntries int
+	TTL        time.Duration
+}
+
+type memoEntry[V comparable, R any] struct {
+	key     uint64
+	input   []V
+	result  R
+	expires time.Time
+}
+
+/*
+MemoizeByHash wraps a function over slices so that its result is cached, keyed by
+the HashSlice hash of the input. Calling the returned function again with equal
+contents returns the cached result without calling fn.
+
+Every cached result keeps a copy of its input, which is compared with the new
+input on a hit, so inputs whose hashes collide never share a result; they evict
+each other instead. The returned function is safe for concurrent use. Concurrent
+calls with the same uncached input may each call fn.
+
+Parameters:
+  - fn: The function to memoize. It must not modify its input.
+  - config: The cache size and expiry.
+
+Returns:
+  - A function with the same behaviour as fn, backed by the cache.
+*/
+func MemoizeByHash[V comparable, R any](fn func(slice []V) R, config MemoConfig) func(slice []V) R {
+	var (
+		mu      sync.Mutex
+		order   = list.New()
+		entries = make(map[uint64]*list.Element)
+	)
+
+	return func(slice []V) R {
+		key := HashSlice(slice, 0)
+
+		mu.Lock()
+		if el, ok := entries[key]; ok {
+			entry := el.Value.(*memoEntry[V, R])
+
+			if slices.Equal(entry.input, slice) && (config.TTL <= 0 || time.Now().Before(entry.expires)) {
+				order.MoveToFront(el)
+				mu.Unlock()
+				return entry.result
+			}
+
+			order.Remove(el)
+			delete(entries, key)
+		}
+		mu.Unlock()
+
+		result := fn(slice)
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		if el, ok := entries[key]; ok {
+			order.Remove(el)
+		}
+
+		entry := &memoEntry[V, R]{key: key, input: slices.Clone(slice), result: result}
+		if config.TTL > 0 {
+			entry.expires = time.Now().Add(config.TTL)
+		}
+		entries[key] = order.PushFront(entry)
+
+		if config.MaxEntries > 0 && order.Len() > config.MaxEntries {
+			oldest := order.Back()
+			order.Remove(oldest)
+			delete(entries, oldest.Value.(*memoEntry[V, R]).key)
+		}
+
+		return result
+	}
+}
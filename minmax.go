@@ -0,0 +1,109 @@
+package arrays
+
+import "cmp"
+
+/*
+Min returns the smallest element of the provided slice. Among equal elements, the
+first one wins.
+
+Returns:
+  - The smallest element and true, or the zero value and false if the slice is
+    empty.
+*/
+func Min[V cmp.Ordered](slice []V) (V, bool) {
+	return MinFunc(cmp.Less[V], slice)
+}
+
+/*
+Max returns the largest element of the provided slice. Among equal elements, the
+first one wins.
+
+Returns:
+  - The largest element and true, or the zero value and false if the slice is empty.
+*/
+func Max[V cmp.Ordered](slice []V) (V, bool) {
+	return MaxFunc(cmp.Less[V], slice)
+}
+
+/*
+MinBy returns the element of the provided slice with the smallest key. Among
+elements with equal keys, the first one wins.
+
+Parameters:
+  - keyFn: A function that takes a value and returns its key. It is called once per
+    element.
+  - slice: The slice to search.
+
+Returns:
+  - The element with the smallest key and true, or the zero value and false if the
+    slice is empty.
+*/
+func MinBy[V any, K cmp.Ordered](keyFn func(value V) K, slice []V) (V, bool) {
+	return extremeBy(keyFn, slice, func(a, b K) bool { return a < b })
+}
+
+/*
+MaxBy returns the element of the provided slice with the largest key. Among
+elements with equal keys, the first one wins.
+
+Parameters:
+  - keyFn: A function that takes a value and returns its key. It is called once per
+    element.
+  - slice: The slice to search.
+
+Returns:
+  - The element with the largest key and true, or the zero value and false if the
+    slice is empty.
+*/
+func MaxBy[V any, K cmp.Ordered](keyFn func(value V) K, slice []V) (V, bool) {
+	return extremeBy(keyFn, slice, func(a, b K) bool { return a > b })
+}
+
+/*
+MinFunc returns the smallest element of the provided slice according to the
+specified comparison. Among equal elements, the first one wins.
+
+Parameters:
+  - less: A function that reports whether a sorts before b.
+  - slice: The slice to search.
+
+Returns:
+  - The smallest element and true, or the zero value and false if the slice is
+    empty.
+*/
+func MinFunc[V any](less func(a, b V) bool, slice []V) (V, bool) {
+	return extremeBy(identity[V], slice, less)
+}
+
+/*
+MaxFunc returns the largest element of the provided slice according to the
+specified comparison. Among equal elements, the first one wins.
+
+Parameters:
+  - less: A function that reports whether a sorts before b.
+  - slice: The slice to search.
+
+Returns:
+  - The largest element and true, or the zero value and false if the slice is empty.
+*/
+func MaxFunc[V any](less func(a, b V) bool, slice []V) (V, bool) {
+	return extremeBy(identity[V], slice, func(a, b V) bool { return less(b, a) })
+}
+
+// extremeBy returns the first element whose key beats the keys of all the others.
+func extremeBy[V, K any](keyFn func(value V) K, slice []V, better func(a, b K) bool) (V, bool) {
+	if len(slice) == 0 {
+		var zero V
+		return zero, false
+	}
+
+	best, bestKey := slice[0], keyFn(slice[0])
+
+	for _, v := range slice[1:] {
+		if k := keyFn(v); better(k, bestKey) {
+			best, bestKey = v, k
+		}
+	}
+
+	return best, true
+}
@@ -0,0 +1,107 @@
+package arrays
+
+/*
+Integer is a constraint that permits any integer type.
+*/
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+/*
+Float is a constraint that permits any floating-point type.
+*/
+type Float interface {
+	~float32 | ~float64
+}
+
+/*
+Number is a constraint that permits any integer or floating-point type.
+*/
+type Number interface {
+	Integer | Float
+}
+
+/*
+Sum returns the sum of the elements of the provided slice, or 0 if it is empty. The
+sum is accumulated in V, so it may overflow for narrow integer types; see SumAs.
+*/
+func Sum[V Number](slice []V) V {
+	var sum V
+	for _, v := range slice {
+		sum += v
+	}
+
+	return sum
+}
+
+/*
+SumAs returns the sum of the elements of the provided slice accumulated in the type
+A, which is given explicitly, for example SumAs[int64](int32s). Choosing a wider A
+than the element type avoids overflow.
+*/
+func SumAs[A, V Number](slice []V) A {
+	var sum A
+	for _, v := range slice {
+		sum += A(v)
+	}
+
+	return sum
+}
+
+/*
+SumBy returns the sum of the values returned by the specified function for each
+element of the provided slice, or 0 if it is empty.
+
+Parameters:
+  - selector: A function that takes a value and returns the number to add.
+  - slice: The slice to sum over.
+
+Returns:
+  - The sum.
+*/
+func SumBy[V any, N Number](selector func(value V) N, slice []V) N {
+	var sum N
+	for _, v := range slice {
+		sum += selector(v)
+	}
+
+	return sum
+}
+
+/*
+Product returns the product of the elements of the provided slice, or 1 if it is
+empty.
+*/
+func Product[V Number](slice []V) V {
+	product := V(1)
+	for _, v := range slice {
+		product *= v
+	}
+
+	return product
+}
+
+/*
+MeanBy returns the arithmetic mean of the values returned by the specified function
+for each element of the provided slice. The values are accumulated as float64.
+
+Parameters:
+  - selector: A function that takes a value and returns the number to average.
+  - slice: The slice to average over.
+
+Returns:
+  - The mean and true, or 0 and false if the slice is empty.
+*/
+func MeanBy[V any, N Number](selector func(value V) N, slice []V) (float64, bool) {
+	if len(slice) == 0 {
+		return 0, false
+	}
+
+	var sum float64
+	for _, v := range slice {
+		sum += float64(selector(v))
+	}
+
+	return sum / float64(len(slice)), true
+}
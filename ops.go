@@ -0,0 +1,237 @@
+package arrays
+
+import "fmt"
+
+/*
+OpKind identifies the kind of an Op.
+*/
+type OpKind int
+
+const (
+	// OpInsert inserts Value at Index, shifting later elements to the right.
+	OpInsert OpKind = iota + 1
+	// OpDelete removes the element at Index, shifting later elements to the left.
+	OpDelete
+	// OpReplace replaces the element at Index with Value.
+	OpReplace
+	// OpMove moves the element at Index so that it ends up at To.
+	OpMove
+)
+
+/*
+Op is a single edit of a slice.
+
+Fields:
+  - Kind: The kind of edit.
+  - Index: The position the edit applies to.
+  - Value: The value inserted or written. Used by OpInsert and OpReplace only.
+  - To: The position the element ends up at. Used by OpMove only.
+
+Ops only hold exported fields, so they can be serialized and replayed elsewhere with
+ApplyOps.
+*/
+type Op[V any] struct {
+	Kind  OpKind
+	Index int
+	Value V
+	To    int
+}
+
+/*
+InsertOp returns an op inserting value at index. An index equal to the length of the
+slice appends.
+*/
+func InsertOp[V any](index int, value V) Op[V] {
+	return Op[V]{Kind: OpInsert, Index: index, Value: value}
+}
+
+/*
+DeleteOp returns an op removing the element at index.
+*/
+func DeleteOp[V any](index int) Op[V] {
+	return Op[V]{Kind: OpDelete, Index: index}
+}
+
+/*
+ReplaceOp returns an op replacing the element at index with value.
+*/
+func ReplaceOp[V any](index int, value V) Op[V] {
+	return Op[V]{Kind: OpReplace, Index: index, Value: value}
+}
+
+/*
+MoveOp returns an op moving the element at from so that it ends up at to, shifting
+the elements in between.
+*/
+func MoveOp[V any](from, to int) Op[V] {
+	return Op[V]{Kind: OpMove, Index: from, To: to}
+}
+
+/*
+ApplyOps applies the provided ops in order to a copy of the provided slice.
+
+Parameters:
+  - slice: The slice to start from. It is never modified.
+  - ops: The ops to apply.
+
+Returns:
+  - The resulting slice.
+  - An error wrapping ErrIndexOutOfRange, naming the position of the failing op, if
+    an op does not fit the slice it is applied to. The slice is then nil.
+*/
+func ApplyOps[V any](slice []V, ops []Op[V]) ([]V, error) {
+	result := make([]V, len(slice))
+	copy(result, slice)
+
+	for i, op := range ops {
+		var err error
+
+		result, _, err = applyOp(result, op)
+		if err != nil {
+			return nil, fmt.Errorf("op %d: %w", i, err)
+		}
+	}
+
+	return result, nil
+}
+
+/*
+Recorder wraps a slice and records every edit made through it as an op, so that
+the same edits can be replayed elsewhere with ApplyOps.
+
+A Recorder must be created with NewRecorder, and is not safe for concurrent use.
+*/
+type Recorder[V any] struct {
+	slice []V
+	ops   []Op[V]
+}
+
+/*
+NewRecorder creates a recorder over a copy of the provided slice.
+
+Parameters:
+  - slice: The initial contents. The slice itself is never modified.
+
+Returns:
+  - A new recorder with no recorded ops.
+*/
+func NewRecorder[V any](slice []V) *Recorder[V] {
+	s := make([]V, len(slice))
+	copy(s, slice)
+
+	return &Recorder[V]{slice: s}
+}
+
+/*
+Apply applies an op to the current contents and records it.
+
+Parameters:
+  - op: The op to apply.
+
+Returns:
+  - An error wrapping ErrIndexOutOfRange if the op does not fit the current
+    contents, in which case nothing is changed or recorded.
+*/
+func (r *Recorder[V]) Apply(op Op[V]) error {
+	slice, _, err := applyOp(r.slice, op)
+	if err != nil {
+		return err
+	}
+
+	r.slice = slice
+	r.ops = append(r.ops, op)
+
+	return nil
+}
+
+/*
+Insert inserts value at index and records it. See Apply.
+*/
+func (r *Recorder[V]) Insert(index int, value V) error {
+	return r.Apply(InsertOp(index, value))
+}
+
+/*
+Delete removes the element at index and records it. See Apply.
+*/
+func (r *Recorder[V]) Delete(index int) error {
+	return r.Apply(DeleteOp[V](index))
+}
+
+/*
+Replace replaces the element at index with value and records it. See Apply.
+*/
+func (r *Recorder[V]) Replace(index int, value V) error {
+	return r.Apply(ReplaceOp(index, value))
+}
+
+/*
+Move moves the element at from so that it ends up at to, and records it. See Apply.
+*/
+func (r *Recorder[V]) Move(from, to int) error {
+	return r.Apply(MoveOp[V](from, to))
+}
+
+/*
+Value returns the current contents. The returned slice is owned by the recorder and
+must not be modified; it is only valid until the next edit.
+*/
+func (r *Recorder[V]) Value() []V {
+	return r.slice
+}
+
+/*
+Ops returns a copy of the ops recorded so far, in the order they were applied.
+*/
+func (r *Recorder[V]) Ops() []Op[V] {
+	ops := make([]Op[V], len(r.ops))
+	copy(ops, r.ops)
+
+	return ops
+}
+
+// applyOp applies op to slice, possibly in place, and returns the updated slice
+// together with the op that undoes it.
+func applyOp[V any](slice []V, op Op[V]) ([]V, Op[V], error) {
+	limit := len(slice)
+	if op.Kind == OpInsert {
+		limit++
+	}
+
+	if op.Index < 0 || op.Index >= limit {
+		return slice, Op[V]{}, fmt.Errorf("%w: index %d with length %d", ErrIndexOutOfRange, op.Index, len(slice))
+	}
+	if op.Kind == OpMove && (op.To < 0 || op.To >= len(slice)) {
+		return slice, Op[V]{}, fmt.Errorf("%w: index %d with length %d", ErrIndexOutOfRange, op.To, len(slice))
+	}
+
+	switch op.Kind {
+	case OpInsert:
+		var zero V
+		slice = append(slice, zero)
+		copy(slice[op.Index+1:], slice[op.Index:])
+		slice[op.Index] = op.Value
+		return slice, DeleteOp[V](op.Index), nil
+	case OpDelete:
+		old := slice[op.Index]
+		copy(slice[op.Index:], slice[op.Index+1:])
+		var zero V
+		slice[len(slice)-1] = zero
+		return slice[:len(slice)-1], InsertOp(op.Index, old), nil
+	case OpReplace:
+		old := slice[op.Index]
+		slice[op.Index] = op.Value
+		return slice, ReplaceOp(op.Index, old), nil
+	case OpMove:
+		moved := slice[op.Index]
+		if op.Index < op.To {
+			copy(slice[op.Index:], slice[op.Index+1:op.To+1])
+		} else {
+			copy(slice[op.To+1:], slice[op.To:op.Index])
+		}
+		slice[op.To] = moved
+		return slice, MoveOp[V](op.To, op.Index), nil
+	default:
+		return slice, Op[V]{}, fmt.Errorf("arrays: unknown op kind %d", op.Kind)
+	}
+}
@@ -0,0 +1,380 @@
+package arrays
+
+import (
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+/*
+Option configures the parallel functions of this package.
+*/
+type Option func(*options)
+
+type options struct {
+	workers      int
+	progress     func(done, total int)
+	progressStep int
+}
+
+/*
+WithWorkers sets the maximum number of goroutines used by a parallel function.
+Non-positive values fall back to the default, runtime.GOMAXPROCS(0).
+
+Parameters:
+  - n: The maximum number of goroutines.
+
+Returns:
+  - An option to pass to a parallel function.
+*/
+func WithWorkers(n int) Option {
+	return func(o *options) {
+		o.workers = n
+	}
+}
+
+func newOptions(opts []Option) options {
+	o := options{}
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if o.workers <= 0 {
+		o.workers = runtime.GOMAXPROCS(0)
+	}
+
+	return o
+}
+
+// parallelRanges splits [0, n) into at most workers contiguous ranges of nearly
+// equal size and calls fn for each of them in its own goroutine. It returns once
+// every call has finished; if any of them panicked, the first panic is re-raised
+// in the calling goroutine.
+func parallelRanges(n, workers int, fn func(part, start, end int)) {
+	if workers > n {
+		workers = n
+	}
+	if workers <= 0 {
+		return
+	}
+
+	var (
+		wg        sync.WaitGroup
+		panicOnce sync.Once
+		panicked  any
+	)
+
+	size, rest := n/workers, n%workers
+	start := 0
+
+	for part := 0; part < workers; part++ {
+		end := start + size
+		if part < rest {
+			end++
+		}
+
+		wg.Add(1)
+		go func(part, start, end int) {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					panicOnce.Do(func() { panicked = r })
+				}
+			}()
+
+			fn(part, start, end)
+		}(part, start, end)
+
+		start = end
+	}
+
+	wg.Wait()
+
+	if panicked != nil {
+		panic(panicked)
+	}
+}
+
+/*
+ParallelReduce reduces the provided slice concurrently: the slice is split into
+contiguous chunks, each chunk is reduced in its own goroutine starting from
+initialAccumulator, and the partial results are then merged in order with the
+combiner function.
+
+For the result to match Reduce, combiner must be associative and
+initialAccumulator must be its identity element (for example 0 for a sum).
+
+Parameters:
+  - reducer: A function that takes an accumulator value, an index, and a value, and
+    returns a new accumulator value.
+  - combiner: A function that merges two partial results, the left one covering the
+    elements before the right one.
+  - slice: The slice to reduce.
+  - initialAccumulator: The initial value for the accumulator of every chunk.
+  - opts: Options such as WithWorkers and WithProgress.
+
+Returns:
+  - The final accumulator value.
+*/
+func ParallelReduce[V, A any](
+	reducer func(accumulator A, index int, value V) A,
+	combiner func(left, right A) A,
+	slice []V,
+	initialAccumulator A,
+	opts ...Option,
+) A {
+	o := newOptions(opts)
+
+	if len(slice) == 0 {
+		return initialAccumulator
+	}
+
+	workers := o.workers
+	if workers > len(slice) {
+		workers = len(slice)
+	}
+
+	partials := make([]A, workers)
+	progress := newProgressTracker(o, len(slice))
+
+	parallelRanges(len(slice), workers, func(part, start, end int) {
+		acc := initialAccumulator
+
+		for i := start; i < end; i++ {
+			acc = reducer(acc, i, slice[i])
+			progress.add(1)
+		}
+
+		partials[part] = acc
+	})
+
+	acc := partials[0]
+	for _, p := range partials[1:] {
+		acc = combiner(acc, p)
+	}
+
+	return acc
+}
+
+// parallelSortThreshold is the length below which ParallelSortBy falls back to a
+// sequential sort, because the goroutine and merge overhead outweighs the gain.
+const parallelSortThreshold = 1 << 12
+
+/*
+ParallelSortBy sorts the provided slice in place using a parallel merge sort: the
+slice is split into contiguous chunks that are sorted concurrently, and the sorted
+runs are then merged pairwise, also concurrently, until one run remains.
+
+The sort is stable. It needs a temporary buffer as large as the slice, and falls
+back to sort.SliceStable for small slices or a single worker.
+
+Parameters:
+  - less: A function that reports whether a must sort before b.
+  - slice: The slice to sort.
+  - opts: Options such as WithWorkers.
+*/
+func ParallelSortBy[V any](less func(a, b V) bool, slice []V, opts ...Option) {
+	o := newOptions(opts)
+
+	if o.workers == 1 || len(slice) < parallelSortThreshold {
+		sort.SliceStable(slice, func(i, j int) bool { return less(slice[i], slice[j]) })
+		return
+	}
+
+	type run struct{ start, end int }
+
+	workers := min(o.workers, len(slice))
+	runs := make([]run, workers)
+
+	parallelRanges(len(slice), workers, func(part, start, end int) {
+		chunk := slice[start:end]
+		sort.SliceStable(chunk, func(i, j int) bool { return less(chunk[i], chunk[j]) })
+		runs[part] = run{start, end}
+	})
+
+	src, dst := slice, make([]V, len(slice))
+
+	for len(runs) > 1 {
+		merged := make([]run, (len(runs)+1)/2)
+
+		parallelRanges(len(merged), len(merged), func(part, _, _ int) {
+			if 2*part+1 == len(runs) {
+				r := runs[2*part]
+				copy(dst[r.start:r.end], src[r.start:r.end])
+				merged[part] = r
+				return
+			}
+
+			left, right := runs[2*part], runs[2*part+1]
+			mergeSorted(less, dst[left.start:right.end], src[left.start:left.end], src[right.start:right.end])
+			merged[part] = run{left.start, right.end}
+		})
+
+		runs = merged
+		src, dst = dst, src
+	}
+
+	if &src[0] != &slice[0] {
+		copy(slice, src)
+	}
+}
+
+// mergeSorted merges the sorted slices left and right into dst, which must be
+// exactly as long as both of them together. Ties are taken from left first.
+func mergeSorted[V any](less func(a, b V) bool, dst, left, right []V) {
+	i, j, k := 0, 0, 0
+
+	for i < len(left) && j < len(right) {
+		if less(right[j], left[i]) {
+			dst[k] = right[j]
+			j++
+		} else {
+			dst[k] = left[i]
+			i++
+		}
+		k++
+	}
+
+	k += copy(dst[k:], left[i:])
+	copy(dst[k:], right[j:])
+}
+
+/*
+ParallelUnique returns a new slice containing the first occurrence of each distinct
+element of the provided slice, in their original order, deduplicating concurrently.
+
+The slice is split into contiguous shards. Each shard is first deduplicated on its
+own with a per-shard set, and then every shard drops the elements already present in
+the sets of the shards before it. Both phases run in parallel and the sets are only
+read once built, so no locking is involved.
+
+Parameters:
+  - slice: The slice to deduplicate.
+  - opts: Options such as WithWorkers.
+
+Returns:
+  - A new slice with the duplicates removed.
+*/
+func ParallelUnique[V comparable](slice []V, opts ...Option) []V {
+	o := newOptions(opts)
+
+	workers := o.workers
+	if workers > len(slice) {
+		workers = len(slice)
+	}
+
+	sets := make([]map[V]struct{}, workers)
+	shards := make([][]V, workers)
+
+	parallelRanges(len(slice), workers, func(part, start, end int) {
+		set := make(map[V]struct{}, end-start)
+		shard := make([]V, 0, end-start)
+
+		for _, v := range slice[start:end] {
+			if _, ok := set[v]; !ok {
+				set[v] = struct{}{}
+				shard = append(shard, v)
+			}
+		}
+
+		sets[part], shards[part] = set, shard
+	})
+
+	parallelRanges(workers, workers, func(part, _, _ int) {
+		shard := shards[part][:0]
+
+		for _, v := range shards[part] {
+			seen := false
+			for _, set := range sets[:part] {
+				if _, seen = set[v]; seen {
+					break
+				}
+			}
+
+			if !seen {
+				shard = append(shard, v)
+			}
+		}
+
+		shards[part] = shard
+	})
+
+	total := 0
+	for _, shard := range shards {
+		total += len(shard)
+	}
+
+	result := make([]V, 0, total)
+	for _, shard := range shards {
+		result = append(result, shard...)
+	}
+
+	return result
+}
+
+// parallelEach calls fn for every index in [0, n) from at most workers goroutines.
+// Indexes are handed out one at a time, so slow calls do not hold up a whole
+// range. Panics are re-raised in the calling goroutine, as in parallelRanges.
+func parallelEach(n, workers int, fn func(i int)) {
+	var next atomic.Int64
+
+	parallelRanges(min(workers, n), min(workers, n), func(_, _, _ int) {
+		for {
+			i := int(next.Add(1) - 1)
+			if i >= n {
+				return
+			}
+
+			fn(i)
+		}
+	})
+}
+
+/*
+MapParallel applies the specified transform function to each element of the
+provided slice from a bounded pool of goroutines, and returns the results in input
+order. It suits transforms dominated by waiting, such as network calls.
+
+Elements are handed to workers one at a time, so a slow element does not hold up
+the others. If the transform panics, MapParallel waits for the running calls to
+finish, stops handing out elements, and re-raises the panic in the calling
+goroutine.
+
+Parameters:
+  - transform: A function that takes an index and a value, and returns the
+    transformed value. It is called concurrently.
+  - slice: The slice to transform.
+  - workers: The maximum number of concurrent calls. Non-positive values fall back
+    to runtime.GOMAXPROCS(0).
+  - opts: Options such as WithProgress. They are applied after workers.
+
+Returns:
+  - A new slice containing the transformed values, in input order.
+*/
+func MapParallel[V, R any](transform func(index int, value V) R, slice []V, workers int, opts ...Option) []R {
+	o := newOptions(append([]Option{WithWorkers(workers)}, opts...))
+	result := make([]R, len(slice))
+	progress := newProgressTracker(o, len(slice))
+
+	var failed atomic.Bool
+
+	parallelEach(len(slice), o.workers, func(i int) {
+		if failed.Load() {
+			return
+		}
+
+		defer func() {
+			if r := recover(); r != nil {
+				failed.Store(true)
+				panic(r)
+			}
+		}()
+
+		result[i] = transform(i, slice[i])
+		progress.add(1)
+	})
+
+	return result
+}
@@ -0,0 +1,186 @@
+package arrays
+
+import (
+	"fmt"
+	"sort"
+)
+
+// checkPermutation reports whether order holds every index of [0, n) exactly once.
+func checkPermutation(order []int, n int) error {
+	if len(order) != n {
+		return fmt.Errorf("%w: %d indexes for %d elements", ErrNotPermutation, len(order), n)
+	}
+
+	seen := make([]bool, n)
+	for _, i := range order {
+		if i < 0 || i >= n || seen[i] {
+			return fmt.Errorf("%w: index %d is out of range or repeated", ErrNotPermutation, i)
+		}
+		seen[i] = true
+	}
+
+	return nil
+}
+
+/*
+Reorder returns a copy of the provided slice rearranged so that element i of the
+result is slice[order[i]]. Applying the permutation returned by a sort of one slice
+to other parallel slices keeps them aligned.
+
+Parameters:
+  - slice: The slice to rearrange.
+  - order: A permutation of the indexes of the slice.
+
+Returns:
+  - A new slice with the rearranged elements, or nil on error.
+  - nil, or an error wrapping ErrNotPermutation if order is not a permutation.
+*/
+func Reorder[V any](slice []V, order []int) ([]V, error) {
+	if err := checkPermutation(order, len(slice)); err != nil {
+		return nil, err
+	}
+
+	result := make([]V, len(slice))
+	for i, j := range order {
+		result[i] = slice[j]
+	}
+
+	return result, nil
+}
+
+/*
+ApplyPermutation rearranges the provided slice in place like Reorder, following the
+cycles of the permutation so that only one element at a time is held aside instead
+of a full copy.
+
+Parameters:
+  - slice: The slice to rearrange.
+  - order: A permutation of the indexes of the slice. It is not modified.
+
+Returns:
+  - nil, or an error wrapping ErrNotPermutation if order is not a permutation, in
+    which case the slice is left unchanged.
+*/
+func ApplyPermutation[V any](slice []V, order []int) error {
+	if err := checkPermutation(order, len(slice)); err != nil {
+		return err
+	}
+
+	done := make([]bool, len(slice))
+
+	for start := range slice {
+		if done[start] {
+			continue
+		}
+
+		held := slice[start]
+		j := start
+
+		for {
+			done[j] = true
+
+			k := order[j]
+			if k == start {
+				slice[j] = held
+				break
+			}
+
+			slice[j] = slice[k]
+			j = k
+		}
+	}
+
+	return nil
+}
+
+/*
+SortIndex returns the permutation that would sort the provided slice, without
+modifying it: the element at index i of the sorted slice is slice[result[i]]. The
+sort is stable.
+
+Passing the result to Reorder or ApplyPermutation sorts the slice, and applies the
+same order to any slice kept in parallel with it.
+
+Parameters:
+  - slice: The slice to sort.
+  - less: A function that reports whether a sorts before b.
+
+Returns:
+  - A new slice of indexes.
+*/
+func SortIndex[V any](slice []V, less func(a, b V) bool) []int {
+	order := make([]int, len(slice))
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(i, j int) bool { return less(slice[order[i]], slice[order[j]]) })
+
+	return order
+}
+
+/*
+RankTies selects how Rank assigns ranks to equal elements.
+*/
+type RankTies int
+
+const (
+	// RankAverage gives equal elements the average of the ranks they span, as used
+	// by most non-parametric statistics: 1, 2.5, 2.5, 4.
+	RankAverage RankTies = iota
+	// RankMin gives equal elements the lowest rank they span: 1, 2, 2, 4.
+	RankMin
+	// RankMax gives equal elements the highest rank they span: 1, 3, 3, 4.
+	RankMax
+	// RankDense gives equal elements the same rank, with no gaps after them:
+	// 1, 2, 2, 3.
+	RankDense
+)
+
+/*
+Rank returns the 1-based rank of every element of the provided slice in ascending
+order, with equal elements (neither sorts before the other) ranked according to the
+tie policy.
+
+Parameters:
+  - slice: The values to rank.
+  - less: A function that reports whether a sorts before b.
+  - ties: The policy for equal elements.
+
+Returns:
+  - A new slice with the rank of every element, aligned with the input.
+*/
+func Rank[V any](slice []V, less func(a, b V) bool, ties RankTies) []float64 {
+	order := SortIndex(slice, less)
+	ranks := make([]float64, len(slice))
+
+	dense := 0
+	for start := 0; start < len(order); {
+		end := start + 1
+		for end < len(order) && !less(slice[order[start]], slice[order[end]]) {
+			end++
+		}
+
+		dense++
+
+		var rank float64
+		switch ties {
+		case RankMin:
+			rank = float64(start + 1)
+		case RankMax:
+			rank = float64(end)
+		case RankDense:
+			rank = float64(dense)
+		default:
+			rank = float64(start+1+end) / 2
+		}
+
+		for _, i := range order[start:end] {
+			ranks[i] = rank
+		}
+
+		start = end
+	}
+
+	return ranks
+}
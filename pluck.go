@@ -0,0 +1,75 @@
+package arrays
+
+/*
+Pluck extracts one field from every element of the provided slice. It is Map for the
+common case where the transform only reads a field and does not need the index.
+
+Parameters:
+  - slice: The slice to read from.
+  - selector: A function that takes a value and returns the field to extract.
+
+Returns:
+  - A new slice of the same length as the input with the extracted fields.
+*/
+func Pluck[V, F any](slice []V, selector func(value V) F) []F {
+	result := make([]F, len(slice))
+
+	for i, v := range slice {
+		result[i] = selector(v)
+	}
+
+	return result
+}
+
+/*
+PluckUnique extracts one field from every element of the provided slice and keeps
+only the first occurrence of each distinct field value, in a single pass.
+
+Parameters:
+  - slice: The slice to read from.
+  - selector: A function that takes a value and returns the field to extract.
+
+Returns:
+  - A new slice with the distinct extracted fields, in order of first occurrence.
+*/
+func PluckUnique[V any, F comparable](slice []V, selector func(value V) F) []F {
+	seen := make(map[F]struct{})
+	var result []F
+
+	for _, v := range slice {
+		f := selector(v)
+
+		if _, ok := seen[f]; !ok {
+			seen[f] = struct{}{}
+			result = append(result, f)
+		}
+	}
+
+	return result
+}
+
+/*
+PluckToMap extracts a key and a value from every element of the provided slice into
+a map. When several elements share a key, the last one wins.
+
+Parameters:
+  - slice: The slice to read from.
+  - keySelector: A function that takes a value and returns the map key.
+  - valueSelector: A function that takes a value and returns the map value.
+
+Returns:
+  - A new map from extracted keys to extracted values.
+*/
+func PluckToMap[V any, K comparable, F any](
+	slice []V,
+	keySelector func(value V) K,
+	valueSelector func(value V) F,
+) map[K]F {
+	result := make(map[K]F, len(slice))
+
+	for _, v := range slice {
+		result[keySelector(v)] = valueSelector(v)
+	}
+
+	return result
+}
@@ -0,0 +1,96 @@
+package arrays
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+/*
+WithProgress sets a function that a long-running function calls as it makes
+progress, for example to drive a progress bar. It is honoured by ParallelReduce,
+MapParallel and ForEachRateLimitedParallel; ProcessWithRetry takes the same
+callback in its RetryConfig.
+
+The callback is called from the worker goroutines, but never concurrently, with a
+non-decreasing number of finished elements. It is called at least once more when
+every element is finished, with done equal to total, unless the input is empty.
+
+Parameters:
+  - callback: A function that takes the number of finished elements and the total.
+
+Returns:
+  - An option to pass to a parallel function.
+*/
+func WithProgress(callback func(done, total int)) Option {
+	return func(o *options) {
+		o.progress = callback
+	}
+}
+
+/*
+WithProgressStep sets how often the WithProgress callback is called: once every n
+finished elements, and once at the end. Non-positive values fall back to the
+default, which reports every whole percent.
+
+Parameters:
+  - n: The number of elements between calls.
+
+Returns:
+  - An option to pass to a parallel function.
+*/
+func WithProgressStep(n int) Option {
+	return func(o *options) {
+		o.progressStep = n
+	}
+}
+
+// progressTracker counts finished elements and reports them to a WithProgress
+// callback. A nil *progressTracker ignores progress, so callers need not check
+// whether reporting is enabled.
+type progressTracker struct {
+	callback func(done, total int)
+	step     int64
+	total    int64
+
+	done atomic.Int64
+
+	mu       sync.Mutex
+	reported int64
+}
+
+// newProgressTracker returns the tracker for a run over total elements, or nil if
+// the options set no callback.
+func newProgressTracker(o options, total int) *progressTracker {
+	if o.progress == nil {
+		return nil
+	}
+
+	step := o.progressStep
+	if step <= 0 {
+		step = max(total/100, 1)
+	}
+
+	return &progressTracker{callback: o.progress, step: int64(step), total: int64(total)}
+}
+
+// add records n more finished elements, and calls the callback if a step boundary
+// or the end was crossed.
+func (p *progressTracker) add(n int) {
+	if p == nil || n <= 0 {
+		return
+	}
+
+	done := p.done.Add(int64(n))
+	if done/p.step == (done-int64(n))/p.step && done != p.total {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// Another goroutine may have reported a larger count in the meantime.
+	if done = p.done.Load(); done > p.reported {
+		p.reported = done
+		p.callback(int(done), int(p.total))
+	}
+}
@@ -0,0 +1,198 @@
+package arrays
+
+import (
+	"math"
+	"sort"
+)
+
+/*
+DefaultTDigestCompression is the compression used by NewTDigest when a
+non-positive compression is given. It bounds the digest to a few hundred centroids
+while keeping the relative error of extreme quantiles well under one percent.
+*/
+const DefaultTDigestCompression = 100
+
+type centroid struct {
+	mean   float64
+	weight float64
+}
+
+/*
+TDigest is a streaming quantile estimator based on the merging t-digest.
+
+It summarizes an arbitrarily long stream of values with a bounded number of
+centroids, and answers quantile queries with an error that is smallest near the
+tails of the distribution. A TDigest must be created with NewTDigest or
+TDigestFromSlice, and is not safe for concurrent use.
+*/
+type TDigest struct {
+	compression float64
+	centroids   []centroid
+	buffer      []centroid
+	total       float64
+	min         float64
+	max         float64
+}
+
+/*
+NewTDigest creates an empty t-digest.
+
+Parameters:
+  - compression: Controls the trade-off between accuracy and size. Larger values
+    keep more centroids and give more accurate estimates. Non-positive values fall
+    back to DefaultTDigestCompression.
+
+Returns:
+  - A new, empty t-digest.
+*/
+func NewTDigest(compression float64) *TDigest {
+	if compression <= 0 {
+		compression = DefaultTDigestCompression
+	}
+
+	return &TDigest{
+		compression: compression,
+		buffer:      make([]centroid, 0, int(5*compression)),
+		min:         math.Inf(1),
+		max:         math.Inf(-1),
+	}
+}
+
+/*
+TDigestFromSlice creates a t-digest containing every element of the provided slice.
+
+Parameters:
+  - slice: The values to add.
+  - compression: See NewTDigest.
+
+Returns:
+  - A new t-digest summarizing the slice.
+*/
+func TDigestFromSlice[V Number](slice []V, compression float64) *TDigest {
+	d := NewTDigest(compression)
+
+	for _, v := range slice {
+		d.Add(float64(v))
+	}
+
+	return d
+}
+
+/*
+Add adds a single value to the digest. NaN values are ignored.
+
+Parameters:
+  - value: The value to add.
+*/
+func (d *TDigest) Add(value float64) {
+	if math.IsNaN(value) {
+		return
+	}
+
+	d.buffer = append(d.buffer, centroid{mean: value, weight: 1})
+	d.total++
+	d.min = math.Min(d.min, value)
+	d.max = math.Max(d.max, value)
+
+	if len(d.buffer) == cap(d.buffer) {
+		d.compress()
+	}
+}
+
+/*
+Count returns the number of values added so far.
+*/
+func (d *TDigest) Count() int {
+	return int(d.total)
+}
+
+/*
+Quantile returns an estimate of the value below which the fraction q of the added
+values fall.
+
+Parameters:
+  - q: The quantile to estimate, between 0 and 1 inclusive.
+
+Returns:
+  - The estimated value, or NaN if the digest is empty or q is outside [0, 1].
+*/
+func (d *TDigest) Quantile(q float64) float64 {
+	if d.total == 0 || q < 0 || q > 1 {
+		return math.NaN()
+	}
+
+	d.compress()
+
+	if q == 0 {
+		return d.min
+	}
+	if q == 1 {
+		return d.max
+	}
+	if len(d.centroids) == 1 {
+		return d.centroids[0].mean
+	}
+
+	index := q * d.total
+
+	first := d.centroids[0]
+	if index < first.weight/2 {
+		return d.min + (first.mean-d.min)*index/(first.weight/2)
+	}
+
+	center := first.weight / 2
+	for i := 1; i < len(d.centroids); i++ {
+		prev, next := d.centroids[i-1], d.centroids[i]
+		nextCenter := center + (prev.weight+next.weight)/2
+
+		if index < nextCenter {
+			return prev.mean + (next.mean-prev.mean)*(index-center)/(nextCenter-center)
+		}
+
+		center = nextCenter
+	}
+
+	last := d.centroids[len(d.centroids)-1]
+	return last.mean + (d.max-last.mean)*(index-center)/(last.weight/2)
+}
+
+// compress merges the buffered values into the centroids, using the k1 scale
+// function to bound how much weight each centroid may absorb.
+func (d *TDigest) compress() {
+	if len(d.buffer) == 0 {
+		return
+	}
+
+	all := append(d.buffer, d.centroids...)
+	sort.Slice(all, func(i, j int) bool { return all[i].mean < all[j].mean })
+
+	merged := make([]centroid, 0, len(d.centroids)+1)
+	merged = append(merged, all[0])
+
+	weightSoFar := 0.0
+	limit := d.quantileLimit(0)
+
+	for _, c := range all[1:] {
+		cur := &merged[len(merged)-1]
+
+		if (weightSoFar+cur.weight+c.weight)/d.total <= limit {
+			cur.weight += c.weight
+			cur.mean += (c.mean - cur.mean) * c.weight / cur.weight
+			continue
+		}
+
+		weightSoFar += cur.weight
+		limit = d.quantileLimit(weightSoFar / d.total)
+		merged = append(merged, c)
+	}
+
+	d.centroids = merged
+	d.buffer = d.buffer[:0]
+}
+
+// quantileLimit returns the largest quantile a centroid starting at q may reach,
+// i.e. the inverse of the scale function at k(q)+1.
+func (d *TDigest) quantileLimit(q float64) float64 {
+	k := d.compression / (2 * math.Pi) * math.Asin(2*q-1)
+	return (math.Sin((k+1)*2*math.Pi/d.compression) + 1) / 2
+}
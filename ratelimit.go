@@ -0,0 +1,104 @@
+package arrays
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+/*
+ForEachRateLimited calls the specified action function for each element of the
+provided slice, in order, starting at most perSecond calls per second. The first
+call starts immediately, and calls are spaced evenly; a call that takes longer than
+the spacing delays the next one, but no burst is made up for it afterwards.
+
+Parameters:
+  - slice: The slice to iterate over.
+  - perSecond: The maximum number of calls started per second. If it is not
+    positive, calls are not limited.
+  - action: A function that takes an index and a value.
+*/
+func ForEachRateLimited[V any](slice []V, perSecond float64, action func(index int, value V)) {
+	limiter := newRateLimiter(perSecond)
+
+	for i, v := range slice {
+		limiter.wait()
+		action(i, v)
+	}
+}
+
+/*
+ForEachRateLimitedParallel is ForEachRateLimited with the calls spread across a
+bounded pool of goroutines, for actions dominated by waiting, such as network calls.
+The rate limit applies to all the goroutines together, so both the number of
+concurrent calls and the number of calls started per second are bounded.
+
+Calls may run and finish in any order. If the action panics,
+ForEachRateLimitedParallel stops starting calls, waits for the running ones to
+finish, and re-raises the panic in the calling goroutine.
+
+Parameters:
+  - slice: The slice to iterate over.
+  - perSecond: The maximum number of calls started per second. If it is not
+    positive, calls are not limited.
+  - action: A function that takes an index and a value. It is called concurrently.
+  - opts: Options such as WithWorkers and WithProgress.
+*/
+func ForEachRateLimitedParallel[V any](slice []V, perSecond float64, action func(index int, value V), opts ...Option) {
+	o := newOptions(opts)
+	limiter := newRateLimiter(perSecond)
+	progress := newProgressTracker(o, len(slice))
+
+	var failed atomic.Bool
+
+	parallelEach(len(slice), o.workers, func(i int) {
+		if failed.Load() {
+			return
+		}
+
+		defer func() {
+			if r := recover(); r != nil {
+				failed.Store(true)
+				panic(r)
+			}
+		}()
+
+		limiter.wait()
+		action(i, slice[i])
+		progress.add(1)
+	})
+}
+
+// rateLimiter spaces out calls to wait evenly, handing out one start time per call
+// so that concurrent callers share the limit.
+type rateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+func newRateLimiter(perSecond float64) *rateLimiter {
+	if perSecond <= 0 {
+		return &rateLimiter{}
+	}
+
+	return &rateLimiter{interval: time.Duration(float64(time.Second) / perSecond)}
+}
+
+// wait blocks until the caller's turn comes.
+func (l *rateLimiter) wait() {
+	if l.interval <= 0 {
+		return
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	start := l.next
+	if start.Before(now) {
+		start = now
+	}
+	l.next = start.Add(l.interval)
+	l.mu.Unlock()
+
+	time.Sleep(time.Until(start))
+}
@@ -0,0 +1,119 @@
+package arrays
+
+import (
+	"sync"
+	"time"
+)
+
+/*
+DedupeWindow remembers the keys seen recently, to drop repeated events from a
+stream. A key counts as recent if it is among the last Size keys passed to Seen, if
+it was passed within the last TTL, or both when both limits are set.
+
+Memory is bounded by the number of keys in the window. A DedupeWindow is safe for
+concurrent use.
+*/
+type DedupeWindow[K comparable] struct {
+	size int
+	ttl  time.Duration
+
+	mu     sync.Mutex
+	queue  []recentKey[K]
+	head   int
+	counts map[K]int
+}
+
+type recentKey[K comparable] struct {
+	key K
+	at  time.Time
+}
+
+/*
+NewDedupeWindow returns an empty DedupeWindow.
+
+Parameters:
+  - size: The number of most recent keys to remember. Non-positive values mean no
+    limit on the count.
+  - ttl: How long a key is remembered. Non-positive values mean no limit on the
+    age. If both limits are non-positive, every key is remembered forever.
+
+Returns:
+  - The window.
+*/
+func NewDedupeWindow[K comparable](size int, ttl time.Duration) *DedupeWindow[K] {
+	return &DedupeWindow[K]{size: size, ttl: ttl, counts: make(map[K]int)}
+}
+
+/*
+Seen reports whether the specified key is in the window, and then records it as
+the most recent key. Repeated keys are recorded too, so a key that keeps arriving
+stays in the window.
+*/
+func (w *DedupeWindow[K]) Seen(key K) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var now time.Time
+	if w.ttl > 0 {
+		now = time.Now()
+	}
+
+	w.evict(now)
+	seen := w.counts[key] > 0
+
+	w.queue = append(w.queue, recentKey[K]{key: key, at: now})
+	w.counts[key]++
+	w.evict(now)
+
+	return seen
+}
+
+// evict drops the keys that fell out of the window, oldest first.
+func (w *DedupeWindow[K]) evict(now time.Time) {
+	for w.head < len(w.queue) {
+		oldest := w.queue[w.head]
+		if !(w.size > 0 && len(w.queue)-w.head > w.size) && !(w.ttl > 0 && now.Sub(oldest.at) >= w.ttl) {
+			break
+		}
+
+		if w.counts[oldest.key]--; w.counts[oldest.key] == 0 {
+			delete(w.counts, oldest.key)
+		}
+
+		w.queue[w.head] = recentKey[K]{}
+		w.head++
+	}
+
+	// Reclaim the evicted prefix once it makes up half of the queue.
+	if w.head > 0 && w.head >= len(w.queue)/2 {
+		w.queue = w.queue[:copy(w.queue, w.queue[w.head:])]
+		w.head = 0
+	}
+}
+
+/*
+DedupeRecent returns the elements of the provided slice whose key is not among the
+keys of the window elements before them. Unlike Dedupe, a key may appear again once
+it has fallen out of the window.
+
+Parameters:
+  - slice: The slice to deduplicate.
+  - key: A function that takes a value and returns its key.
+  - window: The number of preceding elements to compare keys with. If it is not
+    positive, every preceding element is compared, as in UniqBy.
+
+Returns:
+  - A new slice with the recently repeated elements removed.
+*/
+func DedupeRecent[V any, K comparable](slice []V, key func(value V) K, window int) []V {
+	w := NewDedupeWindow[K](window, 0)
+	result := make([]V, 0, len(slice))
+
+	for _, v := range slice {
+		if !w.Seen(key(v)) {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,139 @@
+package arrays
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+/*
+RetryConfig configures ProcessWithRetry. The zero value processes the whole slice
+as a single batch, once, without retries.
+
+Fields:
+  - BatchSize: The maximum number of elements per batch. Non-positive values put
+    the whole slice in one batch.
+  - Retries: How many times a failing batch is retried before it is given up on.
+  - Backoff: A function that takes the number of the retry, starting at 1, and
+    returns how long to wait before it. If nil, retries run immediately.
+  - Bisect: Whether a batch that still fails after its retries is split in half,
+    with each half processed (and retried) on its own, down to single elements.
+    This isolates the elements that make a batch fail, so the rest get through.
+  - OnFailure: A function called with every batch that is given up on and its last
+    error, for example to send the batch to a dead-letter queue. It may be nil.
+  - Progress: A function called after every batch that succeeds or is given up on,
+    with the number of elements finished so far and the total. It may be nil.
+*/
+type RetryConfig[V any] struct {
+	BatchSize int
+	Retries   int
+	Backoff   func(retry int) time.Duration
+	Bisect    bool
+	OnFailure func(batch []V, err error)
+	Progress  func(done, total int)
+}
+
+/*
+ExponentialBackoff returns a RetryConfig.Backoff function that waits initial before
+the first retry and twice as long before every following one, up to limit.
+
+Parameters:
+  - initial: The wait before the first retry.
+  - limit: The longest wait. If it is not positive, waits are only capped at the
+    largest time.Duration, instead of overflowing.
+
+Returns:
+  - A backoff function.
+*/
+func ExponentialBackoff(initial, limit time.Duration) func(retry int) time.Duration {
+	return func(retry int) time.Duration {
+		wait := initial
+		for i := 1; i < retry && (limit <= 0 || wait < limit); i++ {
+			if wait > math.MaxInt64/2 {
+				wait = math.MaxInt64
+				break
+			}
+
+			wait *= 2
+		}
+
+		if limit > 0 && wait > limit {
+			wait = limit
+		}
+
+		return wait
+	}
+}
+
+/*
+ProcessWithRetry calls the specified function with consecutive batches of the
+provided slice, retrying the batches that fail as configured. A batch that is given
+up on does not stop the processing of the following ones.
+
+Batches share the backing array of the input, as in Chunk. The function is called
+from the calling goroutine, one batch at a time.
+
+Parameters:
+  - slice: The slice to process.
+  - fn: A function that takes a batch and processes it, or returns an error.
+  - config: The batch size, retry and failure policy.
+
+Returns:
+  - nil if every element was processed, or the errors of all batches given up on,
+    joined with errors.Join. Each of them is annotated with the range of elements
+    of its batch.
+*/
+func ProcessWithRetry[V any](slice []V, fn func(batch []V) error, config RetryConfig[V]) error {
+	size := config.BatchSize
+	if size <= 0 {
+		size = max(len(slice), 1)
+	}
+
+	var errs []error
+
+	ForEachChunk(slice, size, func(chunkIndex int, batch []V) {
+		errs = processBatch(batch, chunkIndex*size, fn, &config, errs)
+
+		if config.Progress != nil {
+			config.Progress(chunkIndex*size+len(batch), len(slice))
+		}
+	})
+
+	return errors.Join(errs...)
+}
+
+// processBatch runs fn on batch, which starts at element offset of the input, with
+// the retries of config, and bisects it on failure if configured. It returns errs
+// with the errors of the batches given up on appended.
+func processBatch[V any](batch []V, offset int, fn func(batch []V) error, config *RetryConfig[V], errs []error) []error {
+	err := fn(batch)
+	for retry := 1; err != nil && retry <= config.Retries; retry++ {
+		if config.Backoff != nil {
+			time.Sleep(config.Backoff(retry))
+		}
+
+		err = fn(batch)
+	}
+
+	if err == nil {
+		return errs
+	}
+
+	if config.Bisect && len(batch) > 1 {
+		half := len(batch) / 2
+		errs = processBatch(batch[:half:half], offset, fn, config, errs)
+
+		return processBatch(batch[half:], offset+half, fn, config, errs)
+	}
+
+	if config.OnFailure != nil {
+		config.OnFailure(batch, err)
+	}
+
+	if len(batch) == 1 {
+		return append(errs, fmt.Errorf("arrays: element %d: %w", offset, err))
+	}
+
+	return append(errs, fmt.Errorf("arrays: elements %d to %d: %w", offset, offset+len(batch)-1, err))
+}
@@ -0,0 +1,91 @@
+package arrays
+
+import (
+	"errors"
+	"runtime/debug"
+)
+
+/*
+OnPanic selects what SafeMap and SafeForEach do after recovering a panic.
+*/
+type OnPanic int
+
+const (
+	// PanicAbort stops at the first panic.
+	PanicAbort OnPanic = iota
+	// PanicContinue records the panic and carries on with the next element.
+	PanicContinue
+)
+
+/*
+SafeMap is Map that recovers panics raised by the transform function and reports
+them as errors, so that one bad element cannot crash the whole program.
+
+Parameters:
+  - transform: A function that takes an index and a value, and returns the
+    transformed value.
+  - slice: The slice to transform.
+  - onPanic: Whether to stop at the first panic or carry on.
+
+Returns:
+  - A new slice containing the transformed values, or nil if the transform
+    panicked and onPanic is PanicAbort. With PanicContinue, the elements whose
+    transform panicked hold the zero value.
+  - nil, or an error joining one *IndexError per panicking element, in index order,
+    each wrapping a *PanicError with the panic value and stack trace.
+*/
+func SafeMap[V, R any](transform func(index int, value V) R, slice []V, onPanic OnPanic) ([]R, error) {
+	result := make([]R, len(slice))
+
+	err := SafeForEach(func(index int, value V) {
+		result[index] = transform(index, value)
+	}, slice, onPanic)
+	if err != nil && onPanic == PanicAbort {
+		return nil, err
+	}
+
+	return result, err
+}
+
+/*
+SafeForEach is ForEach that recovers panics raised by the action function and
+reports them as errors, so that one bad element cannot crash the whole program.
+
+Parameters:
+  - action: A function that takes an index and a value and performs some action on
+    the value.
+  - slice: The slice to iterate over.
+  - onPanic: Whether to stop at the first panic or carry on.
+
+Returns:
+  - nil, or an error joining one *IndexError per panicking element, in index order,
+    each wrapping a *PanicError with the panic value and stack trace.
+*/
+func SafeForEach[V any](action func(index int, value V), slice []V, onPanic OnPanic) error {
+	var errs []error
+
+	for i, v := range slice {
+		if err := callSafely(action, i, v); err != nil {
+			errs = append(errs, &IndexError{Index: i, Err: err})
+
+			if onPanic == PanicAbort {
+				break
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// callSafely calls action, converting a panic into a *PanicError.
+func callSafely[V any](action func(index int, value V), index int, value V) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = &PanicError{Value: r, Stack: debug.Stack()}
+		}
+	}()
+
+	action(index, value)
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package arrays
+
+/*
+Scan applies the specified reducer function to the elements of the provided slice
+from left to right, like Reduce, and returns a slice with every intermediate
+accumulator value.
+
+The value at index i is the accumulation of slice[:i+1], which makes Scan a natural
+fit for prefix sums and running balances.
+
+Parameters:
+  - reducer: A function that takes an accumulator value, an index, and a value, and
+    returns a new accumulator value.
+  - slice: The slice to scan.
+  - initialAccumulator: The initial value for the accumulator.
+
+Returns:
+  - A new slice of the same length as the input, where each element is the
+    accumulator value after folding the element at that index.
+*/
+func Scan[V, A any](
+	reducer func(accumulator A, index int, value V) A,
+	slice []V,
+	initialAccumulator A,
+) []A {
+	result := make([]A, len(slice))
+	acc := initialAccumulator
+
+	for i, v := range slice {
+		acc = reducer(acc, i, v)
+		result[i] = acc
+	}
+
+	return result
+}
+
+/*
+Accumulate is Scan without an initial accumulator: the first element is the first
+accumulator value, and every following one is combined into it. For example,
+Accumulate with addition turns [1 2 3] into [1 3 6].
+
+Parameters:
+  - combine: A function that takes the accumulator value and the next element, and
+    returns the new accumulator value.
+  - slice: The slice to scan.
+
+Returns:
+  - A new slice of the same length as the input with the running accumulation.
+*/
+func Accumulate[V any](combine func(accumulator, value V) V, slice []V) []V {
+	result := make([]V, len(slice))
+
+	for i, v := range slice {
+		if i > 0 {
+			v = combine(result[i-1], v)
+		}
+
+		result[i] = v
+	}
+
+	return result
+}
+
+/*
+ScanRight applies the specified reducer function to the elements of the provided
+slice from right to left, and returns a slice with every intermediate accumulator
+value.
+
+The result is aligned with the input: the value at index i is the accumulation of
+slice[i:], which makes ScanRight a natural fit for suffix sums and maxima.
+
+Parameters:
+  - reducer: A function that takes an accumulator value, an index, and a value, and
+    returns a new accumulator value.
+  - slice: The slice to scan.
+  - initialAccumulator: The initial value for the accumulator.
+
+Returns:
+  - A new slice of the same length as the input, where each element is the
+    accumulator value after folding the element at that index.
+*/
+func ScanRight[V, A any](
+	reducer func(accumulator A, index int, value V) A,
+	slice []V,
+	initialAccumulator A,
+) []A {
+	result := make([]A, len(slice))
+	acc := initialAccumulator
+
+	for i := len(slice) - 1; i >= 0; i-- {
+		acc = reducer(acc, i, slice[i])
+		result[i] = acc
+	}
+
+	return result
+}
+
+/*
+ReduceRight is Reduce that folds the elements of the provided slice from right to
+left, starting with the last one.
+
+Parameters:
+  - reducer: A function that takes an accumulator value, an index, and a value, and
+    returns a new accumulator value.
+  - slice: The slice to reduce.
+  - initialAccumulator: The initial value for the accumulator.
+
+Returns:
+  - The final accumulator value.
+*/
+func ReduceRight[V, A any](
+	reducer func(accumulator A, index int, value V) A,
+	slice []V,
+	initialAccumulator A,
+) A {
+	acc := initialAccumulator
+
+	for i := len(slice) - 1; i >= 0; i-- {
+		acc = reducer(acc, i, slice[i])
+	}
+
+	return acc
+}
@@ -0,0 +1,286 @@
+package arrays
+
+import (
+	"bytes"
+	"slices"
+)
+
+/*
+Contains reports whether the provided value is present in the slice.
+
+For a []byte, the search is delegated to bytes.IndexByte; a []rune is scanned
+with a loop specialised for runes.
+
+Parameters:
+  - slice: The slice to search.
+  - value: The value to look for.
+
+Returns:
+  - true if at least one element equals the value.
+*/
+func Contains[V comparable](slice []V, value V) bool {
+	if b, ok := any(slice).([]byte); ok {
+		return bytes.IndexByte(b, any(value).(byte)) >= 0
+	}
+	if r, ok := any(slice).([]rune); ok {
+		return indexRune(r, any(value).(rune)) >= 0
+	}
+
+	for _, v := range slice {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+/*
+IndexOfSubslice returns the index of the first occurrence of sub in the provided
+slice, or -1 if it does not occur. An empty sub occurs at index 0.
+
+For a []byte, the search is delegated to bytes.Index; a []rune is searched by
+scanning for the first rune of sub and only comparing the rest at its matches.
+
+Parameters:
+  - slice: The slice to search.
+  - sub: The run of consecutive elements to look for.
+
+Returns:
+  - The index at which sub starts, or -1.
+*/
+func IndexOfSubslice[V comparable](slice, sub []V) int {
+	if b, ok := any(slice).([]byte); ok {
+		return bytes.Index(b, any(sub).([]byte))
+	}
+	if r, ok := any(slice).([]rune); ok {
+		return indexRunes(r, any(sub).([]rune))
+	}
+
+	for i := 0; i+len(sub) <= len(slice); i++ {
+		match := true
+		for j := range sub {
+			if slice[i+j] != sub[j] {
+				match = false
+				break
+			}
+		}
+
+		if match {
+			return i
+		}
+	}
+
+	return -1
+}
+
+/*
+Split slices the provided slice into all subslices separated by sep, like
+bytes.Split with a single-element separator. The subslices share the backing array
+of the input. An empty slice yields a single empty subslice.
+
+For a []byte, the split is delegated to bytes.Split.
+
+Parameters:
+  - slice: The slice to split.
+  - sep: The separator element, which is not included in the result.
+
+Returns:
+  - The subslices between separators, including empty ones.
+*/
+func Split[V comparable](slice []V, sep V) [][]V {
+	if b, ok := any(slice).([]byte); ok {
+		return any(bytes.Split(b, []byte{any(sep).(byte)})).([][]V)
+	}
+
+	var result [][]V
+
+	start := 0
+	for i, v := range slice {
+		if v == sep {
+			result = append(result, slice[start:i:i])
+			start = i + 1
+		}
+	}
+
+	return append(result, slice[start:])
+}
+
+// indexRune returns the index of the first r in s, or -1. Unlike the generic loop,
+// it compares runes directly rather than through the type parameter.
+func indexRune(s []rune, r rune) int {
+	for i, c := range s {
+		if c == r {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// indexRunes returns the index of the first occurrence of sub in s, or -1.
+func indexRunes(s, sub []rune) int {
+	if len(sub) == 0 {
+		return 0
+	}
+
+	for i := 0; i+len(sub) <= len(s); {
+		j := indexRune(s[i:len(s)-len(sub)+1], sub[0])
+		if j < 0 {
+			return -1
+		}
+
+		i += j
+		if slices.Equal(s[i+1:i+len(sub)], sub[1:]) {
+			return i
+		}
+		i++
+	}
+
+	return -1
+}
+
+// containsSetThreshold is the number of values above which the Contains* helpers
+// index the slice in a set instead of scanning it once per value.
+const containsSetThreshold = 8
+
+// containsFunc returns a function reporting whether a value is in the slice,
+// choosing between repeated scans and a set depending on how many lookups follow.
+func containsFunc[V comparable](slice []V, lookups int) func(value V) bool {
+	if lookups <= containsSetThreshold {
+		return func(value V) bool { return Contains(slice, value) }
+	}
+
+	set := make(map[V]struct{}, len(slice))
+	for _, v := range slice {
+		set[v] = struct{}{}
+	}
+
+	return func(value V) bool {
+		_, ok := set[value]
+		return ok
+	}
+}
+
+/*
+ContainsAll reports whether every one of the provided values is present in the
+slice. It is true when no values are given.
+
+Parameters:
+  - slice: The slice to search.
+  - values: The values to look for.
+
+Returns:
+  - true if all the values are present.
+*/
+func ContainsAll[V comparable](slice []V, values ...V) bool {
+	contains := containsFunc(slice, len(values))
+
+	for _, v := range values {
+		if !contains(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+/*
+ContainsAny reports whether at least one of the provided values is present in the
+slice. It is false when no values are given.
+
+Parameters:
+  - slice: The slice to search.
+  - values: The values to look for.
+
+Returns:
+  - true if any of the values is present.
+*/
+func ContainsAny[V comparable](slice []V, values ...V) bool {
+	contains := containsFunc(slice, len(values))
+
+	for _, v := range values {
+		if contains(v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+/*
+ContainsNone reports whether none of the provided values is present in the slice.
+It is true when no values are given.
+
+Parameters:
+  - slice: The slice to search.
+  - values: The values to look for.
+
+Returns:
+  - true if none of the values is present.
+*/
+func ContainsNone[V comparable](slice []V, values ...V) bool {
+	return !ContainsAny(slice, values...)
+}
+
+/*
+CommonPrefix returns the longest prefix shared by all the provided slices, for
+example the common directory of several paths split into segments.
+
+Parameters:
+  - slices: The slices to compare.
+
+Returns:
+  - A new slice with the common prefix, empty if there is none or no slices were
+    provided.
+*/
+func CommonPrefix[V comparable](slices ...[]V) []V {
+	if len(slices) == 0 {
+		return []V{}
+	}
+
+	n := len(slices[0])
+	for _, s := range slices[1:] {
+		n = min(n, len(s))
+
+		for i := 0; i < n; i++ {
+			if s[i] != slices[0][i] {
+				n = i
+				break
+			}
+		}
+	}
+
+	return append([]V{}, slices[0][:n]...)
+}
+
+/*
+CommonSuffix returns the longest suffix shared by all the provided slices.
+
+Parameters:
+  - slices: The slices to compare.
+
+Returns:
+  - A new slice with the common suffix, empty if there is none or no slices were
+    provided.
+*/
+func CommonSuffix[V comparable](slices ...[]V) []V {
+	if len(slices) == 0 {
+		return []V{}
+	}
+
+	first := slices[0]
+	n := len(first)
+	for _, s := range slices[1:] {
+		n = min(n, len(s))
+
+		for i := 1; i <= n; i++ {
+			if s[len(s)-i] != first[len(first)-i] {
+				n = i - 1
+				break
+			}
+		}
+	}
+
+	return append([]V{}, first[len(first)-n:]...)
+}
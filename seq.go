@@ -0,0 +1,296 @@
+package arrays
+
+import (
+	"cmp"
+	"iter"
+	"math/rand"
+	"slices"
+)
+
+/*
+TakeSeq returns an iterator over at most the first n values of the provided
+sequence. It stops pulling from seq as soon as n values have been produced.
+
+Parameters:
+  - seq: The sequence to read from.
+  - n: The maximum number of values.
+
+Returns:
+  - A lazy iterator.
+*/
+func TakeSeq[V any](seq iter.Seq[V], n int) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		if n <= 0 {
+			return
+		}
+
+		taken := 0
+		for v := range seq {
+			if !yield(v) {
+				return
+			}
+
+			taken++
+			if taken == n {
+				return
+			}
+		}
+	}
+}
+
+/*
+DropSeq returns an iterator over the values of the provided sequence after the
+first n.
+
+Parameters:
+  - seq: The sequence to read from.
+  - n: The number of values to skip.
+
+Returns:
+  - A lazy iterator.
+*/
+func DropSeq[V any](seq iter.Seq[V], n int) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		dropped := 0
+		for v := range seq {
+			if dropped < n {
+				dropped++
+				continue
+			}
+
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+/*
+ChunkSeq returns an iterator over consecutive batches of at most n values of the
+provided sequence. The last batch may be shorter. Each batch is a new slice that
+may be retained.
+
+Parameters:
+  - seq: The sequence to read from.
+  - n: The maximum number of values per batch. If it is not positive, the iterator
+    produces no batches and seq is never pulled, as with Chunk.
+
+Returns:
+  - A lazy iterator of batches.
+*/
+func ChunkSeq[V any](seq iter.Seq[V], n int) iter.Seq[[]V] {
+	return func(yield func([]V) bool) {
+		if n <= 0 {
+			return
+		}
+
+		chunk := make([]V, 0, n)
+
+		for v := range seq {
+			chunk = append(chunk, v)
+			if len(chunk) < n {
+				continue
+			}
+
+			if !yield(chunk) {
+				return
+			}
+			chunk = make([]V, 0, n)
+		}
+
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
+
+/*
+SampleSeq draws a uniform random sample of k values from the provided sequence in a
+single pass, holding only k values in memory (reservoir sampling). The whole
+sequence is consumed.
+
+The randomness comes from the provided source, as in the gen package, so a source
+seeded with a fixed value draws the same sample from the same sequence every time.
+
+Parameters:
+  - src: The source of randomness.
+  - seq: The sequence to sample from.
+  - k: The sample size.
+
+Returns:
+  - A new slice with min(k, number of values) values, in no particular order.
+*/
+func SampleSeq[V any](src rand.Source, seq iter.Seq[V], k int) []V {
+	if k <= 0 {
+		return nil
+	}
+
+	r := rand.New(src)
+	reservoir := make([]V, 0, k)
+
+	seen := 0
+	for v := range seq {
+		seen++
+
+		if len(reservoir) < k {
+			reservoir = append(reservoir, v)
+			continue
+		}
+
+		if j := r.Intn(seen); j < k {
+			reservoir[j] = v
+		}
+	}
+
+	return reservoir
+}
+
+/*
+ZipSeq returns an iterator over pairs of values taken in lockstep from the two
+provided sequences. It stops as soon as either sequence is exhausted.
+
+Parameters:
+  - a: The sequence providing the first value of each pair.
+  - b: The sequence providing the second value of each pair.
+
+Returns:
+  - A lazy iterator of pairs.
+*/
+func ZipSeq[A, B any](a iter.Seq[A], b iter.Seq[B]) iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		nextB, stop := iter.Pull(b)
+		defer stop()
+
+		for va := range a {
+			vb, ok := nextB()
+			if !ok || !yield(va, vb) {
+				return
+			}
+		}
+	}
+}
+
+/*
+MergeSortedSeq lazily merges the provided ascending sequences into a single
+ascending sequence, pulling one value at a time from each of them. Ties are taken
+from the earliest sequence first.
+
+Parameters:
+  - seqs: The sequences to merge, each sorted in ascending order.
+
+Returns:
+  - A lazy iterator over all the values of all the sequences.
+*/
+func MergeSortedSeq[V cmp.Ordered](seqs ...iter.Seq[V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		type source struct {
+			next func() (V, bool)
+			head V
+			ok   bool
+		}
+
+		sources := make([]source, len(seqs))
+		for i, seq := range seqs {
+			next, stop := iter.Pull(seq)
+			defer stop()
+
+			head, ok := next()
+			sources[i] = source{next: next, head: head, ok: ok}
+		}
+
+		for {
+			best := -1
+			for i := range sources {
+				if sources[i].ok && (best < 0 || sources[i].head < sources[best].head) {
+					best = i
+				}
+			}
+
+			if best < 0 {
+				return
+			}
+
+			if !yield(sources[best].head) {
+				return
+			}
+
+			sources[best].head, sources[best].ok = sources[best].next()
+		}
+	}
+}
+
+/*
+ToSeq returns an iterator over the index-value pairs of the provided slice, for use
+with range-over-func code.
+
+Parameters:
+  - slice: The slice to iterate over.
+
+Returns:
+  - A lazy iterator of indexes and values.
+*/
+func ToSeq[V any](slice []V) iter.Seq2[int, V] {
+	return slices.All(slice)
+}
+
+/*
+FromSeq collects the values of the provided sequence into a new slice.
+
+Parameters:
+  - seq: The sequence to collect.
+
+Returns:
+  - A new slice with the values, in order.
+*/
+func FromSeq[V any](seq iter.Seq[V]) []V {
+	return slices.Collect(seq)
+}
+
+/*
+MapSeq returns an iterator that applies the specified transform function to each
+value of the provided sequence as it is consumed. See Map.
+
+Parameters:
+  - transform: A function that takes the position of a value in the sequence and
+    the value, and returns the transformed value.
+  - seq: The sequence to transform.
+
+Returns:
+  - A lazy iterator of transformed values.
+*/
+func MapSeq[V, R any](transform func(index int, value V) R, seq iter.Seq[V]) iter.Seq[R] {
+	return func(yield func(R) bool) {
+		i := 0
+		for v := range seq {
+			if !yield(transform(i, v)) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+/*
+FilterSeq returns an iterator over the values of the provided sequence for which the
+specified predicate function returns true, evaluated as the iterator is consumed.
+See Filter.
+
+Parameters:
+  - predicate: A function that takes the position of a value in the sequence and the
+    value, and returns true if the value should be kept.
+  - seq: The sequence to filter.
+
+Returns:
+  - A lazy iterator of matching values.
+*/
+func FilterSeq[V any](predicate func(index int, value V) bool, seq iter.Seq[V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		i := 0
+		for v := range seq {
+			if predicate(i, v) && !yield(v) {
+				return
+			}
+			i++
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package arrays
+
+// The set operations below treat their inputs as sets: the result never contains
+// two elements with the same key, and when a key repeats, its first occurrence is
+// the one kept. Elements of a come first, in their original order, followed by
+// elements of b where the operation includes them.
+
+func identity[V any](value V) V {
+	return value
+}
+
+func keySet[V any, K comparable](key func(value V) K, slice []V) map[K]struct{} {
+	set := make(map[K]struct{}, len(slice))
+	for _, v := range slice {
+		set[key(v)] = struct{}{}
+	}
+
+	return set
+}
+
+/*
+Union returns the distinct elements present in a or b: those of a in order, followed
+by those of b that are not in a.
+*/
+func Union[V comparable](a, b []V) []V {
+	return UnionBy(identity[V], a, b)
+}
+
+/*
+UnionBy is Union for elements compared by the key returned by the specified
+function.
+*/
+func UnionBy[V any, K comparable](key func(value V) K, a, b []V) []V {
+	seen := make(map[K]struct{}, len(a)+len(b))
+	result := make([]V, 0, len(a)+len(b))
+
+	for _, s := range [][]V{a, b} {
+		for _, v := range s {
+			k := key(v)
+			if _, ok := seen[k]; !ok {
+				seen[k] = struct{}{}
+				result = append(result, v)
+			}
+		}
+	}
+
+	return result
+}
+
+/*
+Intersect returns the distinct elements of a that are also present in b, in the
+order of a.
+*/
+func Intersect[V comparable](a, b []V) []V {
+	return IntersectBy(identity[V], a, b)
+}
+
+/*
+IntersectBy is Intersect for elements compared by the key returned by the specified
+function. The elements are taken from a.
+*/
+func IntersectBy[V any, K comparable](key func(value V) K, a, b []V) []V {
+	inB := keySet(key, b)
+	seen := make(map[K]struct{}, len(a))
+	result := make([]V, 0, min(len(a), len(inB)))
+
+	for _, v := range a {
+		k := key(v)
+		if _, ok := inB[k]; !ok {
+			continue
+		}
+		if _, ok := seen[k]; !ok {
+			seen[k] = struct{}{}
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+/*
+Difference returns the distinct elements of a that are not present in b, in the
+order of a.
+*/
+func Difference[V comparable](a, b []V) []V {
+	return DifferenceBy(identity[V], a, b)
+}
+
+/*
+DifferenceBy is Difference for elements compared by the key returned by the
+specified function.
+*/
+func DifferenceBy[V any, K comparable](key func(value V) K, a, b []V) []V {
+	seen := keySet(key, b)
+	result := make([]V, 0, len(a))
+
+	for _, v := range a {
+		k := key(v)
+		if _, ok := seen[k]; !ok {
+			seen[k] = struct{}{}
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+/*
+SymmetricDifference returns the distinct elements present in exactly one of a and b:
+those of a that are not in b, in order, followed by those of b that are not in a.
+*/
+func SymmetricDifference[V comparable](a, b []V) []V {
+	return SymmetricDifferenceBy(identity[V], a, b)
+}
+
+/*
+SymmetricDifferenceBy is SymmetricDifference for elements compared by the key
+returned by the specified function.
+*/
+func SymmetricDifferenceBy[V any, K comparable](key func(value V) K, a, b []V) []V {
+	return append(DifferenceBy(key, a, b), DifferenceBy(key, b, a)...)
+}
@@ -0,0 +1,47 @@
+package arrays
+
+/*
+Lag returns a copy of the provided slice shifted n positions towards the end, so
+that each position holds the element n places before it. The first n positions are
+padded with fill.
+
+Parameters:
+  - slice: The slice to shift.
+  - n: The number of positions to shift by. A negative n shifts the other way, as
+    Lead does.
+  - fill: The value used for positions without a source element.
+
+Returns:
+  - A new slice of the same length as the input.
+*/
+func Lag[V any](slice []V, n int, fill V) []V {
+	result := make([]V, len(slice))
+
+	for i := range result {
+		if j := i - n; j >= 0 && j < len(slice) {
+			result[i] = slice[j]
+		} else {
+			result[i] = fill
+		}
+	}
+
+	return result
+}
+
+/*
+Lead returns a copy of the provided slice shifted n positions towards the start, so
+that each position holds the element n places after it. The last n positions are
+padded with fill.
+
+Parameters:
+  - slice: The slice to shift.
+  - n: The number of positions to shift by. A negative n shifts the other way, as
+    Lag does.
+  - fill: The value used for positions without a source element.
+
+Returns:
+  - A new slice of the same length as the input.
+*/
+func Lead[V any](slice []V, n int, fill V) []V {
+	return Lag(slice, -n, fill)
+}
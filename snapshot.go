@@ -0,0 +1,115 @@
+package arrays
+
+import "slices"
+
+// checkpointPageSize is the number of elements per page of a Checkpoint, the unit
+// that SnapshotShared shares between checkpoints.
+const checkpointPageSize = 256
+
+/*
+Checkpoint is an immutable copy of a slice taken by Snapshot or SnapshotShared.
+Later changes to the original slice do not affect it, and nothing can modify it, so
+it can be restored any number of times.
+
+The elements are stored in fixed-size pages. Checkpoints taken with SnapshotShared
+reuse the unchanged pages of the previous checkpoint, so a series of checkpoints of
+a large, slowly changing slice costs memory in proportion to what changed.
+*/
+type Checkpoint[V any] struct {
+	pages [][]V
+	n     int
+}
+
+/*
+Snapshot takes an immutable copy of the provided slice, to roll back to after a
+failed batch of changes. It copies every element; see SnapshotShared to share
+memory with an earlier checkpoint.
+
+Parameters:
+  - slice: The slice to copy.
+
+Returns:
+  - A checkpoint holding the current contents of the slice.
+*/
+func Snapshot[V any](slice []V) Checkpoint[V] {
+	c := Checkpoint[V]{pages: make([][]V, 0, (len(slice)+checkpointPageSize-1)/checkpointPageSize), n: len(slice)}
+
+	ForEachChunk(slice, checkpointPageSize, func(_ int, page []V) {
+		c.pages = append(c.pages, slices.Clone(page))
+	})
+
+	return c
+}
+
+/*
+SnapshotShared is Snapshot that shares memory with an earlier checkpoint: every page
+of the slice whose elements all equal the same page of prev is reused instead of
+copied. Checking a page costs a comparison of its elements, but only the pages that
+changed are allocated.
+
+Parameters:
+  - prev: An earlier checkpoint, typically of the same slice. The zero Checkpoint
+    shares nothing.
+  - slice: The slice to copy.
+
+Returns:
+  - A checkpoint holding the current contents of the slice.
+*/
+func SnapshotShared[V comparable](prev Checkpoint[V], slice []V) Checkpoint[V] {
+	c := Checkpoint[V]{pages: make([][]V, 0, (len(slice)+checkpointPageSize-1)/checkpointPageSize), n: len(slice)}
+
+	ForEachChunk(slice, checkpointPageSize, func(i int, page []V) {
+		if i < len(prev.pages) && slices.Equal(prev.pages[i], page) {
+			c.pages = append(c.pages, prev.pages[i])
+		} else {
+			c.pages = append(c.pages, slices.Clone(page))
+		}
+	})
+
+	return c
+}
+
+/*
+Len returns the number of elements in the checkpoint.
+*/
+func (c Checkpoint[V]) Len() int {
+	return c.n
+}
+
+/*
+At returns the element at the specified index. It panics if the index is out of
+range, like indexing a slice.
+*/
+func (c Checkpoint[V]) At(i int) V {
+	return c.pages[i/checkpointPageSize][i%checkpointPageSize]
+}
+
+/*
+Restore returns a new slice with the contents of the checkpoint.
+
+Returns:
+  - A new slice that the caller may freely modify.
+*/
+func (c Checkpoint[V]) Restore() []V {
+	return c.RestoreInto(nil)
+}
+
+/*
+RestoreInto overwrites the provided slice with the contents of the checkpoint,
+reusing its backing array when it is large enough.
+
+Parameters:
+  - dst: The slice to overwrite, typically the working set being rolled back.
+
+Returns:
+  - The restored slice, which should be assigned back to the original variable.
+*/
+func (c Checkpoint[V]) RestoreInto(dst []V) []V {
+	dst = slices.Grow(dst[:0], c.n)
+
+	for _, page := range c.pages {
+		dst = append(dst, page...)
+	}
+
+	return dst
+}
@@ -0,0 +1,87 @@
+package arrays
+
+import (
+	"cmp"
+	"slices"
+)
+
+/*
+SortBy returns a copy of the provided slice sorted in ascending order of the key
+returned by the specified function. The sort is not stable; see SortStableBy.
+
+The key function is called several times per element, so it should be cheap.
+
+Parameters:
+  - keyFn: A function that takes a value and returns its sort key.
+  - slice: The slice to sort. It is not modified.
+
+Returns:
+  - A new, sorted slice.
+*/
+func SortBy[V any, K cmp.Ordered](keyFn func(value V) K, slice []V) []V {
+	result := slices.Clone(slice)
+	slices.SortFunc(result, func(a, b V) int { return cmp.Compare(keyFn(a), keyFn(b)) })
+
+	return result
+}
+
+/*
+SortStableBy is SortBy, but elements with equal keys keep their original order.
+*/
+func SortStableBy[V any, K cmp.Ordered](keyFn func(value V) K, slice []V) []V {
+	result := slices.Clone(slice)
+	slices.SortStableFunc(result, func(a, b V) int { return cmp.Compare(keyFn(a), keyFn(b)) })
+
+	return result
+}
+
+/*
+SortKey is one level of a multi-key ordering for OrderBy. It compares two values and
+returns a negative number, zero, or a positive number as the first one sorts before,
+together with, or after the second. Build it with Asc or Desc.
+*/
+type SortKey[V any] func(a, b V) int
+
+/*
+Asc returns a sort key ordering values by the specified key, in ascending order.
+*/
+func Asc[V any, K cmp.Ordered](keyFn func(value V) K) SortKey[V] {
+	return func(a, b V) int { return cmp.Compare(keyFn(a), keyFn(b)) }
+}
+
+/*
+Desc returns a sort key ordering values by the specified key, in descending order.
+*/
+func Desc[V any, K cmp.Ordered](keyFn func(value V) K) SortKey[V] {
+	return func(a, b V) int { return cmp.Compare(keyFn(b), keyFn(a)) }
+}
+
+/*
+OrderBy returns a copy of the provided slice sorted by several keys: by the first
+key, then by the second among elements with equal first keys, and so on. The sort
+is stable, so elements equal on every key keep their original order.
+
+For example, OrderBy(people, Asc(lastName), Desc(age)).
+
+Parameters:
+  - slice: The slice to sort. It is not modified.
+  - keys: The sort keys, from most to least significant.
+
+Returns:
+  - A new, sorted slice.
+*/
+func OrderBy[V any](slice []V, keys ...SortKey[V]) []V {
+	result := slices.Clone(slice)
+
+	slices.SortStableFunc(result, func(a, b V) int {
+		for _, key := range keys {
+			if c := key(a, b); c != 0 {
+				return c
+			}
+		}
+
+		return 0
+	})
+
+	return result
+}
@@ -0,0 +1,110 @@
+package arrays
+
+import "cmp"
+
+/*
+IntersectionSorted returns the elements present in both of the provided sorted
+slices, using a single merge-style scan and no hashing.
+
+Both slices must be sorted in ascending order. Duplicates are treated as a
+multiset: an element appearing twice in a and three times in b appears twice in
+the result.
+
+Parameters:
+  - a: The first sorted slice.
+  - b: The second sorted slice.
+
+Returns:
+  - A new sorted slice containing the common elements.
+*/
+func IntersectionSorted[V cmp.Ordered](a, b []V) []V {
+	result := make([]V, 0, min(len(a), len(b)))
+
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case b[j] < a[i]:
+			j++
+		default:
+			result = append(result, a[i])
+			i++
+			j++
+		}
+	}
+
+	return result
+}
+
+/*
+UnionSorted returns the elements present in either of the provided sorted slices,
+using a single merge-style scan and no hashing.
+
+Both slices must be sorted in ascending order. Duplicates are treated as a
+multiset: an element appearing twice in a and three times in b appears three times
+in the result.
+
+Parameters:
+  - a: The first sorted slice.
+  - b: The second sorted slice.
+
+Returns:
+  - A new sorted slice containing the elements of both slices.
+*/
+func UnionSorted[V cmp.Ordered](a, b []V) []V {
+	result := make([]V, 0, len(a)+len(b))
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] < b[j]:
+			result = append(result, a[i])
+			i++
+		case b[j] < a[i]:
+			result = append(result, b[j])
+			j++
+		default:
+			result = append(result, a[i])
+			i++
+			j++
+		}
+	}
+
+	result = append(result, a[i:]...)
+	return append(result, b[j:]...)
+}
+
+/*
+DifferenceSorted returns the elements of the first sorted slice that are not present
+in the second one, using a single merge-style scan and no hashing.
+
+Both slices must be sorted in ascending order. Duplicates are treated as a
+multiset: an element appearing three times in a and once in b appears twice in the
+result.
+
+Parameters:
+  - a: The sorted slice to take elements from.
+  - b: The sorted slice of elements to exclude.
+
+Returns:
+  - A new sorted slice containing the elements of a that are not in b.
+*/
+func DifferenceSorted[V cmp.Ordered](a, b []V) []V {
+	result := make([]V, 0, len(a))
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] < b[j]:
+			result = append(result, a[i])
+			i++
+		case b[j] < a[i]:
+			j++
+		default:
+			i++
+			j++
+		}
+	}
+
+	return append(result, a[i:]...)
+}
@@ -0,0 +1,294 @@
+package arrays
+
+import (
+	"math"
+	"sort"
+)
+
+/*
+Aggregator accumulates statistics over values that arrive incrementally, without
+retaining the values themselves.
+
+Mean and variance are maintained with Welford's online algorithm, which stays
+numerically stable over long streams. The zero value is an empty aggregator ready
+to use.
+*/
+type Aggregator[V Number] struct {
+	count int
+	min   V
+	max   V
+	mean  float64
+	m2    float64
+}
+
+/*
+Add adds a single value to the aggregator.
+
+Parameters:
+  - value: The value to add.
+*/
+func (a *Aggregator[V]) Add(value V) {
+	if a.count == 0 || value < a.min {
+		a.min = value
+	}
+	if a.count == 0 || value > a.max {
+		a.max = value
+	}
+
+	a.count++
+
+	x := float64(value)
+	delta := x - a.mean
+	a.mean += delta / float64(a.count)
+	a.m2 += delta * (x - a.mean)
+}
+
+/*
+AddSlice adds every element of the provided slice to the aggregator.
+
+Parameters:
+  - slice: The values to add.
+*/
+func (a *Aggregator[V]) AddSlice(slice []V) {
+	for _, v := range slice {
+		a.Add(v)
+	}
+}
+
+/*
+Count returns the number of values added so far.
+*/
+func (a *Aggregator[V]) Count() int {
+	return a.count
+}
+
+/*
+Min returns the smallest value added so far.
+
+Returns:
+  - The smallest value, and true, or the zero value and false if nothing was added.
+*/
+func (a *Aggregator[V]) Min() (V, bool) {
+	return a.min, a.count > 0
+}
+
+/*
+Max returns the largest value added so far.
+
+Returns:
+  - The largest value, and true, or the zero value and false if nothing was added.
+*/
+func (a *Aggregator[V]) Max() (V, bool) {
+	return a.max, a.count > 0
+}
+
+/*
+Mean returns the arithmetic mean of the values added so far, or 0 if nothing was
+added.
+*/
+func (a *Aggregator[V]) Mean() float64 {
+	return a.mean
+}
+
+/*
+Variance returns the population variance of the values added so far, or 0 if
+nothing was added.
+*/
+func (a *Aggregator[V]) Variance() float64 {
+	if a.count == 0 {
+		return 0
+	}
+
+	return a.m2 / float64(a.count)
+}
+
+/*
+SampleVariance returns the unbiased sample variance of the values added so far, or
+0 if fewer than two values were added.
+*/
+func (a *Aggregator[V]) SampleVariance() float64 {
+	if a.count < 2 {
+		return 0
+	}
+
+	return a.m2 / float64(a.count-1)
+}
+
+/*
+Indexed is an element together with its index in the slice it was taken from.
+
+Fields:
+  - Index: The index of the element.
+  - Value: The element.
+*/
+type Indexed[V any] struct {
+	Index int
+	Value V
+}
+
+/*
+RemoveOutliersIQR removes the elements that lie more than k interquartile ranges
+below the first quartile or above the third quartile (Tukey's fences). The
+conventional k is 1.5.
+
+Quartiles are computed by linear interpolation between the closest ranks.
+
+Parameters:
+  - slice: The values to clean.
+  - k: The width of the fences, in interquartile ranges.
+
+Returns:
+  - A new slice with the remaining elements, in their original order.
+  - The removed elements with their original indexes.
+*/
+func RemoveOutliersIQR[V Number](slice []V, k float64) ([]V, []Indexed[V]) {
+	sorted := make([]float64, len(slice))
+	for i, v := range slice {
+		sorted[i] = float64(v)
+	}
+	sort.Float64s(sorted)
+
+	q1, q3 := quantileSorted(sorted, 0.25), quantileSorted(sorted, 0.75)
+	lo, hi := q1-k*(q3-q1), q3+k*(q3-q1)
+
+	return splitOutliers(slice, func(v float64) bool { return v < lo || v > hi })
+}
+
+/*
+RemoveOutliersZScore removes the elements whose distance from the mean exceeds
+threshold standard deviations. The population standard deviation is used; if it is
+0, nothing is removed.
+
+Parameters:
+  - slice: The values to clean.
+  - threshold: The largest allowed absolute z-score, typically 3.
+
+Returns:
+  - A new slice with the remaining elements, in their original order.
+  - The removed elements with their original indexes.
+*/
+func RemoveOutliersZScore[V Number](slice []V, threshold float64) ([]V, []Indexed[V]) {
+	var agg Aggregator[V]
+	agg.AddSlice(slice)
+
+	mean, std := agg.Mean(), math.Sqrt(agg.Variance())
+	if std == 0 {
+		return splitOutliers(slice, func(float64) bool { return false })
+	}
+
+	return splitOutliers(slice, func(v float64) bool { return math.Abs(v-mean)/std > threshold })
+}
+
+func splitOutliers[V Number](slice []V, isOutlier func(v float64) bool) ([]V, []Indexed[V]) {
+	kept := make([]V, 0, len(slice))
+	var removed []Indexed[V]
+
+	for i, v := range slice {
+		if isOutlier(float64(v)) {
+			removed = append(removed, Indexed[V]{Index: i, Value: v})
+		} else {
+			kept = append(kept, v)
+		}
+	}
+
+	return kept, removed
+}
+
+// quantileSorted returns the q-quantile of an ascending slice, interpolating
+// linearly between the closest ranks. It returns NaN for an empty slice.
+func quantileSorted(sorted []float64, q float64) float64 {
+	if len(sorted) == 0 {
+		return math.NaN()
+	}
+
+	pos := q * float64(len(sorted)-1)
+	lo := int(math.Floor(pos))
+	hi := min(lo+1, len(sorted)-1)
+
+	return sorted[lo] + (sorted[hi]-sorted[lo])*(pos-float64(lo))
+}
+
+/*
+BinEqualWidth splits the range of the provided values into n bins of equal width
+and assigns every element to one of them.
+
+Parameters:
+  - slice: The values to bin.
+  - n: The number of bins. If it is not positive, or the slice is empty, nil slices
+    are returned.
+
+Returns:
+  - The bin of every element, between 0 and n-1, aligned with the input. The
+    maximum value belongs to the last bin.
+  - The n+1 bin boundaries, in ascending order, from the minimum to the maximum.
+*/
+func BinEqualWidth[V Number](slice []V, n int) ([]int, []float64) {
+	if n <= 0 || len(slice) == 0 {
+		return nil, nil
+	}
+
+	var agg Aggregator[V]
+	agg.AddSlice(slice)
+	lo, _ := agg.Min()
+	hi, _ := agg.Max()
+
+	width := (float64(hi) - float64(lo)) / float64(n)
+	edges := make([]float64, n+1)
+	for i := range edges {
+		edges[i] = float64(lo) + width*float64(i)
+	}
+	edges[n] = float64(hi)
+
+	return assignBins(slice, edges), edges
+}
+
+/*
+BinEqualFrequency chooses n bins holding roughly the same number of elements each
+and assigns every element to one of them. Equal values always share a bin, so bins
+may be uneven when values repeat.
+
+Boundaries are quantiles computed by linear interpolation between the closest
+ranks.
+
+Parameters:
+  - slice: The values to bin.
+  - n: The number of bins. If it is not positive, or the slice is empty, nil slices
+    are returned.
+
+Returns:
+  - The bin of every element, between 0 and n-1, aligned with the input.
+  - The n+1 bin boundaries, in ascending order, from the minimum to the maximum.
+*/
+func BinEqualFrequency[V Number](slice []V, n int) ([]int, []float64) {
+	if n <= 0 || len(slice) == 0 {
+		return nil, nil
+	}
+
+	sorted := make([]float64, len(slice))
+	for i, v := range slice {
+		sorted[i] = float64(v)
+	}
+	sort.Float64s(sorted)
+
+	edges := make([]float64, n+1)
+	for i := range edges {
+		edges[i] = quantileSorted(sorted, float64(i)/float64(n))
+	}
+
+	return assignBins(slice, edges), edges
+}
+
+// assignBins returns, for each value, the index of the bin delimited by edges that
+// contains it. Bins are closed on the left, except the last one, which is closed
+// on both sides.
+func assignBins[V Number](slice []V, edges []float64) []int {
+	inner := edges[1 : len(edges)-1]
+	bins := make([]int, len(slice))
+
+	for i, v := range slice {
+		x := float64(v)
+		bins[i] = sort.Search(len(inner), func(j int) bool { return inner[j] > x })
+	}
+
+	return bins
+}
@@ -0,0 +1,148 @@
+package arrays
+
+import (
+	"iter"
+	"slices"
+)
+
+/*
+Stream is a lazy, single-pass pipeline over a sequence of values. Steps such as
+Filter, Map, and Take only describe the work; nothing runs, and no intermediate
+slice is allocated, until a terminal method such as Collect or ForEach consumes the
+stream. Take stops pulling from upstream as soon as it has enough elements.
+
+Methods cannot introduce new type parameters, so the Map method keeps the element
+type; use MapStream to change it. The index passed to each callback is the position
+of the element in the stream at that step, as if the previous steps had produced a
+slice.
+*/
+type Stream[V any] struct {
+	seq iter.Seq[V]
+}
+
+/*
+From starts a stream over the elements of the provided slice, for example
+From(s).Filter(p).Map(f).Take(10).Collect().
+
+Parameters:
+  - slice: The slice to stream.
+
+Returns:
+  - A stream of the elements of the slice.
+*/
+func From[V any](slice []V) Stream[V] {
+	return Stream[V]{seq: slices.Values(slice)}
+}
+
+/*
+StreamOf starts a stream over the provided sequence.
+
+Parameters:
+  - seq: The sequence to stream.
+
+Returns:
+  - A stream of the values of the sequence.
+*/
+func StreamOf[V any](seq iter.Seq[V]) Stream[V] {
+	return Stream[V]{seq: seq}
+}
+
+/*
+MapStream lazily transforms every element of the stream, possibly into another
+type. See Map.
+
+Parameters:
+  - transform: A function that takes an index and a value, and returns the
+    transformed value.
+  - s: The stream to transform.
+
+Returns:
+  - A stream of the transformed values.
+*/
+func MapStream[V, R any](transform func(index int, value V) R, s Stream[V]) Stream[R] {
+	return Stream[R]{seq: MapSeq(transform, s.seq)}
+}
+
+/*
+Filter lazily keeps only the elements for which the predicate returns true. See
+Filter.
+*/
+func (s Stream[V]) Filter(predicate func(index int, value V) bool) Stream[V] {
+	return Stream[V]{seq: FilterSeq(predicate, s.seq)}
+}
+
+/*
+Map lazily transforms every element into another value of the same type. See Map,
+and MapStream to change the type.
+*/
+func (s Stream[V]) Map(transform func(index int, value V) V) Stream[V] {
+	return MapStream(transform, s)
+}
+
+/*
+Take lazily keeps at most the first n elements, and stops consuming upstream once
+they have been produced.
+*/
+func (s Stream[V]) Take(n int) Stream[V] {
+	return Stream[V]{seq: TakeSeq(s.seq, n)}
+}
+
+/*
+Skip lazily drops the first n elements.
+*/
+func (s Stream[V]) Skip(n int) Stream[V] {
+	return Stream[V]{seq: DropSeq(s.seq, n)}
+}
+
+/*
+Seq returns the stream as a standard iterator, for use with range or other
+iterator-based code.
+*/
+func (s Stream[V]) Seq() iter.Seq[V] {
+	return s.seq
+}
+
+/*
+Collect runs the stream and returns its elements in a new slice.
+*/
+func (s Stream[V]) Collect() []V {
+	return slices.Collect(s.seq)
+}
+
+/*
+ForEach runs the stream and applies the specified action function to each element.
+*/
+func (s Stream[V]) ForEach(action func(index int, value V)) {
+	i := 0
+	for v := range s.seq {
+		action(i, v)
+		i++
+	}
+}
+
+/*
+Count runs the stream and returns the number of elements it produced.
+*/
+func (s Stream[V]) Count() int {
+	n := 0
+	for range s.seq {
+		n++
+	}
+
+	return n
+}
+
+/*
+First runs the stream until it produces its first element.
+
+Returns:
+  - The first element and true, or the zero value and false if the stream is empty.
+*/
+func (s Stream[V]) First() (V, bool) {
+	for v := range s.seq {
+		return v, true
+	}
+
+	var zero V
+	return zero, false
+}
@@ -0,0 +1,64 @@
+/*
+Package strs provides common clean-up helpers for string slices, built on the
+primitives of the arrays package.
+
+Every function returns a new slice and leaves its input unchanged.
+*/
+package strs
+
+import (
+	"strings"
+
+	"github.com/klimovI/arrays"
+)
+
+/*
+TrimSpaceEach returns a new slice with leading and trailing white space removed from
+every string.
+*/
+func TrimSpaceEach(slice []string) []string {
+	return arrays.Map(func(_ int, s string) string { return strings.TrimSpace(s) }, slice)
+}
+
+/*
+ToLowerEach returns a new slice with every string mapped to lower case.
+*/
+func ToLowerEach(slice []string) []string {
+	return arrays.Map(func(_ int, s string) string { return strings.ToLower(s) }, slice)
+}
+
+/*
+NonEmpty returns a new slice without the empty strings.
+*/
+func NonEmpty(slice []string) []string {
+	return arrays.Filter(func(_ int, s string) bool { return s != "" }, slice)
+}
+
+/*
+PrefixEach returns a new slice with prefix prepended to every string.
+*/
+func PrefixEach(slice []string, prefix string) []string {
+	return arrays.Map(func(_ int, s string) string { return prefix + s }, slice)
+}
+
+/*
+SuffixEach returns a new slice with suffix appended to every string.
+*/
+func SuffixEach(slice []string, suffix string) []string {
+	return arrays.Map(func(_ int, s string) string { return s + suffix }, slice)
+}
+
+/*
+SplitAndFlatten splits every string around sep, like strings.Split, and returns all
+the parts in a single slice, in order.
+
+Parameters:
+  - slice: The strings to split.
+  - sep: The separator.
+
+Returns:
+  - A new slice with the parts of every string.
+*/
+func SplitAndFlatten(slice []string, sep string) []string {
+	return arrays.FlatMap(func(_ int, s string) []string { return strings.Split(s, sep) }, slice)
+}
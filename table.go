@@ -0,0 +1,77 @@
+package arrays
+
+/*
+Pivot turns a flat slice of records into a cross-tab indexed by row and column key.
+
+Parameters:
+  - slice: The records to pivot.
+  - rowKey: A function that takes a record and returns its row key.
+  - colKey: A function that takes a record and returns its column key.
+  - value: A function that takes a record and returns the value it contributes to
+    its cell.
+  - aggregate: A function that combines the value already in a cell with the value
+    of another record for the same cell, for example a sum.
+
+Returns:
+  - A map from row key to a map from column key to the cell value. Cells without
+    records are absent.
+*/
+func Pivot[V any, R, C comparable, A any](
+	slice []V,
+	rowKey func(value V) R,
+	colKey func(value V) C,
+	value func(value V) A,
+	aggregate func(existing, incoming A) A,
+) map[R]map[C]A {
+	table := make(map[R]map[C]A)
+
+	for _, v := range slice {
+		r, c, a := rowKey(v), colKey(v), value(v)
+
+		row, ok := table[r]
+		if !ok {
+			row = make(map[C]A)
+			table[r] = row
+		}
+
+		if existing, ok := row[c]; ok {
+			a = aggregate(existing, a)
+		}
+
+		row[c] = a
+	}
+
+	return table
+}
+
+/*
+Unpivot flattens a cross-tab, such as the one built by Pivot, back into a slice of
+records, one per cell.
+
+The order of the records follows Go's map iteration order and is therefore
+unspecified; sort the result if a stable order is needed.
+
+Parameters:
+  - table: A map from row key to a map from column key to the cell value.
+  - combine: A function that takes the row key, column key, and value of a cell,
+    and returns the corresponding record.
+
+Returns:
+  - A new slice with one record per cell.
+*/
+func Unpivot[R, C comparable, A, V any](table map[R]map[C]A, combine func(row R, col C, value A) V) []V {
+	total := 0
+	for _, row := range table {
+		total += len(row)
+	}
+
+	result := make([]V, 0, total)
+
+	for r, row := range table {
+		for c, a := range row {
+			result = append(result, combine(r, c, a))
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,194 @@
+package arrays
+
+import "slices"
+
+/*
+Take returns a copy of the first n elements of the provided slice.
+
+Parameters:
+  - slice: The slice to take from.
+  - n: The number of elements to take. If it is larger than the slice, the whole
+    slice is copied; if it is not positive, the result is empty.
+
+Returns:
+  - A new slice with at most n elements.
+*/
+func Take[V any](slice []V, n int) []V {
+	return slices.Clone(slice[:clampCount(n, len(slice))])
+}
+
+/*
+Drop returns a copy of the provided slice without its first n elements.
+
+Parameters:
+  - slice: The slice to drop from.
+  - n: The number of elements to drop. If it is larger than the slice, the result is
+    empty; if it is not positive, the whole slice is copied.
+
+Returns:
+  - A new slice with the remaining elements.
+*/
+func Drop[V any](slice []V, n int) []V {
+	return slices.Clone(slice[clampCount(n, len(slice)):])
+}
+
+/*
+TakeWhile returns a copy of the longest prefix of the provided slice whose elements
+all satisfy the specified predicate.
+
+Parameters:
+  - predicate: A function that takes an index and a value and returns a boolean.
+  - slice: The slice to take from.
+
+Returns:
+  - A new slice with the elements before the first one that fails the predicate.
+*/
+func TakeWhile[V any](predicate func(index int, value V) bool, slice []V) []V {
+	return slices.Clone(slice[:prefixWhile(predicate, slice)])
+}
+
+/*
+DropWhile returns a copy of the provided slice without its longest prefix of
+elements that satisfy the specified predicate.
+
+Parameters:
+  - predicate: A function that takes an index and a value and returns a boolean.
+  - slice: The slice to drop from.
+
+Returns:
+  - A new slice starting at the first element that fails the predicate.
+*/
+func DropWhile[V any](predicate func(index int, value V) bool, slice []V) []V {
+	return slices.Clone(slice[prefixWhile(predicate, slice):])
+}
+
+/*
+Trim returns a copy of the provided slice without the leading and trailing elements
+equal to any of the specified values, like strings.Trim with a cutset. Matching
+elements in the middle are kept.
+
+Parameters:
+  - slice: The slice to trim.
+  - values: The values to remove from both ends.
+
+Returns:
+  - A new slice with the ends trimmed.
+*/
+func Trim[V comparable](slice []V, values ...V) []V {
+	return TrimFunc(isAnyOf(values), slice)
+}
+
+/*
+TrimLeft is Trim that only removes leading elements.
+*/
+func TrimLeft[V comparable](slice []V, values ...V) []V {
+	return DropWhile(isAnyOf(values), slice)
+}
+
+/*
+TrimRight is Trim that only removes trailing elements.
+*/
+func TrimRight[V comparable](slice []V, values ...V) []V {
+	return slices.Clone(slice[:suffixStart(isAnyOf(values), slice)])
+}
+
+/*
+TrimFunc returns a copy of the provided slice without the leading and trailing
+elements that satisfy the specified predicate.
+
+Parameters:
+  - predicate: A function that takes an index and a value, and returns true if the
+    value should be trimmed.
+  - slice: The slice to trim.
+
+Returns:
+  - A new slice with the ends trimmed.
+*/
+func TrimFunc[V any](predicate func(index int, value V) bool, slice []V) []V {
+	start := prefixWhile(predicate, slice)
+	end := start + suffixStart(func(i int, v V) bool { return predicate(start+i, v) }, slice[start:])
+
+	return slices.Clone(slice[start:end])
+}
+
+// isAnyOf returns a predicate reporting whether a value is one of values.
+func isAnyOf[V comparable](values []V) func(index int, value V) bool {
+	contains := containsFunc(values, len(values))
+
+	return func(_ int, value V) bool { return contains(value) }
+}
+
+/*
+Ellipsize shortens the provided slice to at most limit elements for display, keeping
+its head and replacing the rest with a marker element, as "..." does for text.
+
+Parameters:
+  - slice: The slice to shorten.
+  - limit: The maximum length of the result, marker included.
+  - marker: The element that stands in for the removed ones.
+
+Returns:
+  - A copy of the slice if it has at most limit elements; otherwise a new slice with
+    the first limit-1 elements followed by marker. It is empty if limit is not
+    positive.
+*/
+func Ellipsize[V any](slice []V, limit int, marker V) []V {
+	if len(slice) <= limit {
+		return slices.Clone(slice)
+	}
+	if limit <= 0 {
+		return []V{}
+	}
+
+	return append(slices.Clone(slice[:limit-1:limit-1]), marker)
+}
+
+/*
+TruncateMiddle is Ellipsize that keeps both the head and the tail of the slice,
+with the marker between them. When the kept elements cannot be split evenly, the
+head gets one more than the tail.
+*/
+func TruncateMiddle[V any](slice []V, limit int, marker V) []V {
+	if len(slice) <= limit {
+		return slices.Clone(slice)
+	}
+	if limit <= 0 {
+		return []V{}
+	}
+
+	head, tail := limit/2, (limit-1)/2
+
+	result := make([]V, 0, limit)
+	result = append(result, slice[:head]...)
+	result = append(result, marker)
+
+	return append(result, slice[len(slice)-tail:]...)
+}
+
+// clampCount limits n to the range [0, length].
+func clampCount(n, length int) int {
+	return max(0, min(n, length))
+}
+
+// prefixWhile returns the length of the longest prefix satisfying predicate.
+func prefixWhile[V any](predicate func(index int, value V) bool, slice []V) int {
+	for i, v := range slice {
+		if !predicate(i, v) {
+			return i
+		}
+	}
+
+	return len(slice)
+}
+
+// suffixStart returns the index where the longest suffix satisfying predicate
+// begins.
+func suffixStart[V any](predicate func(index int, value V) bool, slice []V) int {
+	for i := len(slice) - 1; i >= 0; i-- {
+		if !predicate(i, slice[i]) {
+			return i + 1
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,273 @@
+package arrays
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+/*
+Bucket is a time window together with the elements whose timestamps fall in it.
+
+Fields:
+  - Start: The inclusive start of the window.
+  - Items: The elements in the window, in their original order.
+*/
+type Bucket[V any] struct {
+	Start time.Time
+	Items []V
+}
+
+/*
+BucketByTime groups the elements of the provided slice into consecutive time windows
+of the specified length.
+
+Windows are aligned with time.Time.Truncate, so they start at multiples of interval
+since the zero time, regardless of the timestamps present. The input does not need
+to be sorted.
+
+Parameters:
+  - slice: The slice to group.
+  - timestamp: A function that takes a value and returns its timestamp.
+  - interval: The length of each window. If it is not positive, every distinct
+    timestamp forms its own window and no empty windows are emitted.
+  - includeEmpty: Whether to emit the empty windows between the first and the last
+    non-empty one, so that the result is a gap-free series.
+
+Returns:
+  - The windows, sorted by start time.
+*/
+func BucketByTime[V any](
+	slice []V,
+	timestamp func(value V) time.Time,
+	interval time.Duration,
+	includeEmpty bool,
+) []Bucket[V] {
+	var buckets []Bucket[V]
+	positions := make(map[int64]int)
+
+	for _, v := range slice {
+		start := timestamp(v).Truncate(interval)
+		key := start.UnixNano()
+
+		i, ok := positions[key]
+		if !ok {
+			i = len(buckets)
+			positions[key] = i
+			buckets = append(buckets, Bucket[V]{Start: start})
+		}
+
+		buckets[i].Items = append(buckets[i].Items, v)
+	}
+
+	sort.Slice(buckets, func(i, j int) bool { return buckets[i].Start.Before(buckets[j].Start) })
+
+	if !includeEmpty || interval <= 0 || len(buckets) < 2 {
+		return buckets
+	}
+
+	last := buckets[len(buckets)-1].Start
+	dense := make([]Bucket[V], 0, int(last.Sub(buckets[0].Start)/interval)+1)
+
+	for _, b := range buckets {
+		if len(dense) > 0 {
+			for start := dense[len(dense)-1].Start.Add(interval); start.Before(b.Start); start = start.Add(interval) {
+				dense = append(dense, Bucket[V]{Start: start})
+			}
+		}
+
+		dense = append(dense, b)
+	}
+
+	return dense
+}
+
+/*
+Aggregation combines the values that fall in the same window of a time series into
+one. It is never called with an empty slice.
+*/
+type Aggregation func(values []float64) float64
+
+/*
+AggregateMean returns the arithmetic mean of the values.
+*/
+func AggregateMean(values []float64) float64 {
+	return AggregateSum(values) / float64(len(values))
+}
+
+/*
+AggregateSum returns the sum of the values.
+*/
+func AggregateSum(values []float64) float64 {
+	return Sum(values)
+}
+
+/*
+AggregateLast returns the last value, that is, the latest one in time.
+*/
+func AggregateLast(values []float64) float64 {
+	return values[len(values)-1]
+}
+
+/*
+GapFill selects how Resample fills the windows that contain no samples.
+*/
+type GapFill int
+
+const (
+	// GapFillNaN leaves empty windows as NaN.
+	GapFillNaN GapFill = iota
+	// GapFillZero sets empty windows to 0.
+	GapFillZero
+	// GapFillPrevious carries the value of the previous non-empty window forward.
+	GapFillPrevious
+	// GapFillLinear interpolates linearly between the surrounding non-empty windows.
+	GapFillLinear
+)
+
+/*
+Resample converts an irregular time series into one with exactly one value per
+window of the specified length, from the window of the earliest sample to the
+window of the latest one.
+
+Samples are grouped with BucketByTime, so windows are aligned the same way, and the
+samples of each window are combined with the aggregate function in time order.
+
+Parameters:
+  - xs: The timestamps of the samples. They do not need to be sorted.
+  - ys: The values of the samples. If xs and ys differ in length, the extra
+    elements of the longer one are ignored.
+  - interval: The length of each window. It must be positive; otherwise nil slices
+    are returned.
+  - aggregate: The function combining the values of a window, such as AggregateMean,
+    AggregateSum, or AggregateLast.
+  - fill: The policy for windows without samples.
+
+Returns:
+  - The start of every window.
+  - The value of every window.
+*/
+func Resample(
+	xs []time.Time,
+	ys []float64,
+	interval time.Duration,
+	aggregate Aggregation,
+	fill GapFill,
+) ([]time.Time, []float64) {
+	if interval <= 0 {
+		return nil, nil
+	}
+
+	n := min(len(xs), len(ys))
+	indexes := make([]int, n)
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	sort.SliceStable(indexes, func(i, j int) bool { return xs[indexes[i]].Before(xs[indexes[j]]) })
+
+	buckets := BucketByTime(indexes, func(i int) time.Time { return xs[i] }, interval, true)
+
+	times := make([]time.Time, len(buckets))
+	values := make([]float64, len(buckets))
+	var window []float64
+
+	for i, b := range buckets {
+		times[i] = b.Start
+
+		if len(b.Items) == 0 {
+			values[i] = math.NaN()
+			continue
+		}
+
+		window = window[:0]
+		for _, j := range b.Items {
+			window = append(window, ys[j])
+		}
+
+		values[i] = aggregate(window)
+	}
+
+	fillGaps(values, buckets, fill)
+
+	return times, values
+}
+
+// fillGaps replaces the values of the empty buckets according to the policy. The
+// first and last buckets are never empty.
+func fillGaps[V any](values []float64, buckets []Bucket[V], fill GapFill) {
+	prev := -1
+
+	for i, b := range buckets {
+		if len(b.Items) > 0 {
+			if fill == GapFillLinear && prev >= 0 && i-prev > 1 {
+				step := (values[i] - values[prev]) / float64(i-prev)
+				for j := prev + 1; j < i; j++ {
+					values[j] = values[prev] + step*float64(j-prev)
+				}
+			}
+
+			prev = i
+			continue
+		}
+
+		switch fill {
+		case GapFillZero:
+			values[i] = 0
+		case GapFillPrevious:
+			values[i] = values[prev]
+		}
+	}
+}
+
+/*
+EMA returns the exponential moving average of the provided slice, where each output
+is alpha times the current element plus 1-alpha times the previous output. The first
+output is the first element.
+
+Parameters:
+  - slice: The values to smooth, in time order.
+  - alpha: The smoothing factor, between 0 and 1. Larger values follow the input
+    more closely.
+
+Returns:
+  - A new slice of the same length as the input.
+*/
+func EMA[V Number](slice []V, alpha float64) []float64 {
+	result := make([]float64, len(slice))
+
+	for i, v := range slice {
+		if i == 0 {
+			result[i] = float64(v)
+			continue
+		}
+
+		result[i] = alpha*float64(v) + (1-alpha)*result[i-1]
+	}
+
+	return result
+}
+
+/*
+RatePerInterval counts how many of the provided event timestamps fall in each window
+of the specified length, from the window of the earliest event to the window of the
+latest one. Windows are aligned as in BucketByTime, and windows without events have
+a rate of 0.
+
+Parameters:
+  - timestamps: The times at which events happened. They do not need to be sorted.
+  - interval: The length of each window. It must be positive; otherwise nil slices
+    are returned.
+
+Returns:
+  - The start of every window.
+  - The number of events in every window.
+*/
+func RatePerInterval(timestamps []time.Time, interval time.Duration) ([]time.Time, []float64) {
+	ones := make([]float64, len(timestamps))
+	for i := range ones {
+		ones[i] = 1
+	}
+
+	return Resample(timestamps, ones, interval, AggregateSum, GapFillZero)
+}
@@ -0,0 +1,116 @@
+package arrays
+
+import (
+	"container/heap"
+	"sort"
+)
+
+/*
+TopNCollector keeps the n best elements seen so far according to a comparator,
+using a bounded heap so that memory stays proportional to n rather than to the
+number of elements added.
+
+A TopNCollector must be created with NewTopNCollector, and is not safe for
+concurrent use.
+*/
+type TopNCollector[V any] struct {
+	n    int
+	heap topNHeap[V]
+}
+
+/*
+NewTopNCollector creates an empty collector.
+
+Parameters:
+  - n: The maximum number of elements to keep. Non-positive values keep nothing.
+  - less: A function that reports whether a ranks before b. The collector keeps the
+    n elements that come first in this order.
+
+Returns:
+  - A new, empty collector.
+*/
+func NewTopNCollector[V any](n int, less func(a, b V) bool) *TopNCollector[V] {
+	if n < 0 {
+		n = 0
+	}
+
+	return &TopNCollector[V]{
+		n:    n,
+		heap: topNHeap[V]{items: make([]V, 0, n), less: less},
+	}
+}
+
+/*
+Add offers a value to the collector. The value is kept only if fewer than n values
+are held or if it ranks before the worst value currently held.
+
+Parameters:
+  - value: The value to offer.
+*/
+func (c *TopNCollector[V]) Add(value V) {
+	if c.n == 0 {
+		return
+	}
+
+	if len(c.heap.items) < c.n {
+		heap.Push(&c.heap, value)
+		return
+	}
+
+	if c.heap.less(value, c.heap.items[0]) {
+		c.heap.items[0] = value
+		heap.Fix(&c.heap, 0)
+	}
+}
+
+/*
+AddSlice offers every element of the provided slice to the collector.
+
+Parameters:
+  - slice: The values to offer.
+*/
+func (c *TopNCollector[V]) AddSlice(slice []V) {
+	for _, v := range slice {
+		c.Add(v)
+	}
+}
+
+/*
+Len returns the number of values currently held, which never exceeds n.
+*/
+func (c *TopNCollector[V]) Len() int {
+	return len(c.heap.items)
+}
+
+/*
+Items returns the values currently held, best first.
+
+Returns:
+  - A new slice with at most n elements, sorted by the collector's comparator.
+*/
+func (c *TopNCollector[V]) Items() []V {
+	result := make([]V, len(c.heap.items))
+	copy(result, c.heap.items)
+
+	sort.Slice(result, func(i, j int) bool { return c.heap.less(result[i], result[j]) })
+
+	return result
+}
+
+// topNHeap is a heap whose root is the worst of the kept elements, so that it can
+// be evicted in O(log n) when a better element arrives.
+type topNHeap[V any] struct {
+	items []V
+	less  func(a, b V) bool
+}
+
+func (h *topNHeap[V]) Len() int           { return len(h.items) }
+func (h *topNHeap[V]) Less(i, j int) bool { return h.less(h.items[j], h.items[i]) }
+func (h *topNHeap[V]) Swap(i, j int)      { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *topNHeap[V]) Push(x any)         { h.items = append(h.items, x.(V)) }
+
+func (h *topNHeap[V]) Pop() any {
+	last := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return last
+}
@@ -0,0 +1,146 @@
+package arrays
+
+import "fmt"
+
+/*
+Node is an element of a tree built by BuildTree.
+
+Fields:
+  - Value: The element.
+  - Children: The nodes whose parent is this node, in input order.
+*/
+type Node[V any] struct {
+	Value    V
+	Children []*Node[V]
+}
+
+/*
+BuildTree assembles a forest from flat records that each name their parent, such as
+menu entries or an org chart loaded from a database.
+
+Parameters:
+  - slice: The records.
+  - id: A function that takes a record and returns its key.
+  - parent: A function that takes a record and returns the key of its parent, and
+    false if it is a root.
+
+Returns:
+  - The root nodes, in input order, or nil on error.
+  - nil, or an error wrapping ErrDuplicateKey if two records share a key,
+    ErrUnknownKey if a record names a parent that does not exist, or a *CycleError
+    if parents form a loop.
+*/
+func BuildTree[V any, K comparable](slice []V, id func(value V) K, parent func(value V) (K, bool)) ([]*Node[V], error) {
+	nodes := make([]Node[V], len(slice))
+	keys := make([]K, len(slice))
+	index := make(map[K]int, len(slice))
+
+	for i, v := range slice {
+		keys[i] = id(v)
+		if _, ok := index[keys[i]]; ok {
+			return nil, fmt.Errorf("%w: %v", ErrDuplicateKey, keys[i])
+		}
+
+		index[keys[i]] = i
+		nodes[i].Value = v
+	}
+
+	parents := make([]int, len(slice))
+	var roots []*Node[V]
+
+	for i, v := range slice {
+		p, ok := parent(v)
+		if !ok {
+			parents[i] = -1
+			roots = append(roots, &nodes[i])
+			continue
+		}
+
+		j, ok := index[p]
+		if !ok {
+			return nil, fmt.Errorf("%w: parent %v of %v", ErrUnknownKey, p, keys[i])
+		}
+
+		parents[i] = j
+		nodes[j].Children = append(nodes[j].Children, &nodes[i])
+	}
+
+	if reached := len(FlattenTree(roots)); reached < len(slice) {
+		return nil, &CycleError[K]{Cycle: parentCycle(parents, keys)}
+	}
+
+	return roots, nil
+}
+
+// parentCycle follows the parents of every record until it reaches a root or a
+// record already seen on the same walk, and returns the keys of the first loop
+// found, each followed by its parent. It must only be called when a loop exists.
+func parentCycle[K comparable](parents []int, keys []K) []K {
+	const (
+		unvisited = iota
+		onPath
+		done
+	)
+
+	state := make([]int, len(parents))
+
+	for start := range parents {
+		var path []int
+
+		j := start
+		for j >= 0 && state[j] == unvisited {
+			state[j] = onPath
+			path = append(path, j)
+			j = parents[j]
+		}
+
+		if j >= 0 && state[j] == onPath {
+			at := 0
+			for path[at] != j {
+				at++
+			}
+
+			loop := append(path[at:], j)
+
+			cycle := make([]K, len(loop))
+			for n, k := range loop {
+				cycle[n] = keys[k]
+			}
+
+			return cycle
+		}
+
+		for _, k := range path {
+			state[k] = done
+		}
+	}
+
+	return nil
+}
+
+/*
+FlattenTree lists the values of a forest in depth-first pre-order: every node comes
+right before its children, and siblings keep their order. It is the inverse of
+BuildTree, up to ordering.
+
+Parameters:
+  - roots: The root nodes.
+
+Returns:
+  - A new slice with the value of every node.
+*/
+func FlattenTree[V any](roots []*Node[V]) []V {
+	var result []V
+
+	var walk func(nodes []*Node[V])
+	walk = func(nodes []*Node[V]) {
+		for _, n := range nodes {
+			result = append(result, n.Value)
+			walk(n.Children)
+		}
+	}
+
+	walk(roots)
+
+	return result
+}
@@ -0,0 +1,114 @@
+package arrays
+
+/*
+TryMap is Map with a transform function that can fail. It stops at the first error.
+
+Parameters:
+  - transform: A function that takes an index and a value, and returns the
+    transformed value or an error.
+  - slice: The slice to transform.
+
+Returns:
+  - A new slice containing the transformed values, or nil on error.
+  - nil, or an *IndexError wrapping the first error returned by transform.
+*/
+func TryMap[V, R any](transform func(index int, value V) (R, error), slice []V) ([]R, error) {
+	result := make([]R, len(slice))
+
+	for i, v := range slice {
+		r, err := transform(i, v)
+		if err != nil {
+			return nil, &IndexError{Index: i, Err: err}
+		}
+
+		result[i] = r
+	}
+
+	return result, nil
+}
+
+/*
+TryFilter is Filter with a predicate function that can fail. It stops at the first
+error.
+
+Parameters:
+  - predicate: A function that takes an index and a value, and returns true if the
+    value should be included in the result slice, or an error.
+  - slice: The slice to filter.
+
+Returns:
+  - A new slice containing the matching elements, or nil on error.
+  - nil, or an *IndexError wrapping the first error returned by predicate.
+*/
+func TryFilter[V any](predicate func(index int, value V) (bool, error), slice []V) ([]V, error) {
+	result := make([]V, 0, len(slice))
+
+	for i, v := range slice {
+		ok, err := predicate(i, v)
+		if err != nil {
+			return nil, &IndexError{Index: i, Err: err}
+		}
+
+		if ok {
+			result = append(result, v)
+		}
+	}
+
+	return result, nil
+}
+
+/*
+TryReduce is Reduce with a reducer function that can fail. It stops at the first
+error.
+
+Parameters:
+  - reducer: A function that takes an accumulator value, an index, and a value, and
+    returns a new accumulator value or an error.
+  - slice: The slice to reduce.
+  - initialAccumulator: The initial value for the accumulator.
+
+Returns:
+  - The final accumulator value, or the zero value on error.
+  - nil, or an *IndexError wrapping the first error returned by reducer.
+*/
+func TryReduce[V, A any](
+	reducer func(accumulator A, index int, value V) (A, error),
+	slice []V,
+	initialAccumulator A,
+) (A, error) {
+	acc := initialAccumulator
+
+	for i, v := range slice {
+		var err error
+
+		acc, err = reducer(acc, i, v)
+		if err != nil {
+			var zero A
+			return zero, &IndexError{Index: i, Err: err}
+		}
+	}
+
+	return acc, nil
+}
+
+/*
+TryForEach is ForEach with an action function that can fail. It stops at the first
+error; to keep going and collect every error, see ForEachCollectErr.
+
+Parameters:
+  - action: A function that takes an index and a value, performs some action on the
+    value, and returns an error if it failed.
+  - slice: The slice to iterate over.
+
+Returns:
+  - nil, or an *IndexError wrapping the first error returned by action.
+*/
+func TryForEach[V any](action func(index int, value V) error, slice []V) error {
+	for i, v := range slice {
+		if err := action(i, v); err != nil {
+			return &IndexError{Index: i, Err: err}
+		}
+	}
+
+	return nil
+}
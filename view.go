@@ -0,0 +1,196 @@
+package arrays
+
+/*
+View is a read-only selection of elements of an underlying slice. It stores only the
+indexes of the selected elements, so creating it never copies them.
+
+A View reflects later writes to the elements of the underlying slice, but not
+changes to its length.
+*/
+type View[V any] struct {
+	slice   []V
+	indexes []int
+}
+
+/*
+FilterView returns a view of the elements of the provided slice for which the
+specified predicate function returns true. It is the zero-copy counterpart of
+Filter.
+
+Parameters:
+  - predicate: A function that takes an index and a value, and returns true if the
+    value should be included in the view.
+  - slice: The slice to filter.
+
+Returns:
+  - A view of the matching elements.
+*/
+func FilterView[V any](predicate func(index int, value V) bool, slice []V) View[V] {
+	indexes := make([]int, 0, len(slice))
+
+	for i := range slice {
+		if predicate(i, slice[i]) {
+			indexes = append(indexes, i)
+		}
+	}
+
+	return View[V]{slice: slice, indexes: indexes}
+}
+
+/*
+Len returns the number of elements in the view.
+*/
+func (v View[V]) Len() int {
+	return len(v.indexes)
+}
+
+/*
+At returns a copy of the element at the specified position of the view. It panics
+if the position is out of range, like indexing a slice.
+
+Copying costs as much as the element is wide; for large structs, Ptr reads the
+element in place.
+
+Parameters:
+  - i: The position in the view, between 0 and Len()-1.
+
+Returns:
+  - The element. To write to it, use Index with the underlying slice.
+*/
+func (v View[V]) At(i int) V {
+	return v.slice[v.indexes[i]]
+}
+
+/*
+Ptr returns a pointer to the element at the specified position of the view, in the
+underlying slice, so that wide elements can be read without copying them. It panics
+if the position is out of range, like indexing a slice.
+
+The view is read-only: callers must not write through the pointer. Writes through
+Index and the underlying slice are the supported way to change elements.
+
+Parameters:
+  - i: The position in the view, between 0 and Len()-1.
+
+Returns:
+  - A pointer to the element in the underlying slice.
+*/
+func (v View[V]) Ptr(i int) *V {
+	return &v.slice[v.indexes[i]]
+}
+
+/*
+Index returns the index in the underlying slice of the element at the specified
+position of the view.
+
+Parameters:
+  - i: The position in the view, between 0 and Len()-1.
+
+Returns:
+  - The index of the element in the underlying slice.
+*/
+func (v View[V]) Index(i int) int {
+	return v.indexes[i]
+}
+
+/*
+ForEach applies the specified action function to each element of the view.
+
+Parameters:
+  - action: A function that takes a position in the view and a value, and performs
+    some action on the value.
+*/
+func (v View[V]) ForEach(action func(index int, value V)) {
+	for i, idx := range v.indexes {
+		action(i, v.slice[idx])
+	}
+}
+
+/*
+Materialize copies the elements of the view into a new slice.
+
+Returns:
+  - A new slice containing the elements of the view, as Filter would have returned.
+*/
+func (v View[V]) Materialize() []V {
+	result := make([]V, len(v.indexes))
+
+	for i, idx := range v.indexes {
+		result[i] = v.slice[idx]
+	}
+
+	return result
+}
+
+/*
+MappedView is a read-only, random-access view of a slice whose elements are
+transformed lazily: the transform function runs each time an element is read, and
+nothing is allocated up front.
+*/
+type MappedView[V, R any] struct {
+	slice     []V
+	transform func(index int, value V) R
+}
+
+/*
+MapView returns a lazily transformed view of the provided slice. It is the lazy
+counterpart of Map, useful when only a few elements of the result are ever read.
+
+The transform function may be called several times for the same element, so it
+should be cheap and free of side effects.
+
+Parameters:
+  - transform: A function that takes an index and a value, and returns the
+    transformed value.
+  - slice: The slice to transform.
+
+Returns:
+  - A view of the transformed elements.
+*/
+func MapView[V, R any](transform func(index int, value V) R, slice []V) MappedView[V, R] {
+	return MappedView[V, R]{slice: slice, transform: transform}
+}
+
+/*
+Len returns the number of elements in the view.
+*/
+func (v MappedView[V, R]) Len() int {
+	return len(v.slice)
+}
+
+/*
+At transforms and returns the element at the specified index. It panics if the
+index is out of range, like indexing a slice.
+
+Parameters:
+  - i: The index, between 0 and Len()-1.
+
+Returns:
+  - The transformed element.
+*/
+func (v MappedView[V, R]) At(i int) R {
+	return v.transform(i, v.slice[i])
+}
+
+/*
+ForEach applies the specified action function to each transformed element.
+
+Parameters:
+  - action: A function that takes an index and a transformed value, and performs
+    some action on the value.
+*/
+func (v MappedView[V, R]) ForEach(action func(index int, value R)) {
+	for i, value := range v.slice {
+		action(i, v.transform(i, value))
+	}
+}
+
+/*
+Materialize transforms every element into a new slice.
+
+Returns:
+  - A new slice containing the transformed values, as Map would have returned.
+*/
+func (v MappedView[V, R]) Materialize() []R {
+	return Map(v.transform, v.slice)
+}
@@ -0,0 +1,44 @@
+package arrays
+
+/*
+SlidingWindowExtrema returns the best element of every window of the specified size
+sliding over the provided slice, in O(n) overall using a monotonic deque.
+
+"Best" is defined by less: pass a < b to get sliding minima, or a > b to get sliding
+maxima. Among equal best elements, the latest one in the window is returned.
+
+Parameters:
+  - slice: The slice to scan.
+  - window: The number of consecutive elements in each window.
+  - less: A function that reports whether a is better than b.
+
+Returns:
+  - A new slice with len(slice)-window+1 elements, where element i is the best of
+    slice[i:i+window]. It is empty if window is not positive or larger than the
+    slice.
+*/
+func SlidingWindowExtrema[V any](slice []V, window int, less func(a, b V) bool) []V {
+	if window <= 0 || window > len(slice) {
+		return []V{}
+	}
+
+	result := make([]V, 0, len(slice)-window+1)
+	deque := make([]int, 0, window)
+
+	for i, v := range slice {
+		if len(deque) > 0 && deque[0] <= i-window {
+			deque = deque[1:]
+		}
+
+		for len(deque) > 0 && !less(slice[deque[len(deque)-1]], v) {
+			deque = deque[:len(deque)-1]
+		}
+		deque = append(deque, i)
+
+		if i >= window-1 {
+			result = append(result, slice[deque[0]])
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,129 @@
+package arrays
+
+/*
+Pair holds two values of possibly different types.
+
+Fields:
+  - First: The first value.
+  - Second: The second value.
+*/
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+/*
+Triple holds three values of possibly different types.
+
+Fields:
+  - First: The first value.
+  - Second: The second value.
+  - Third: The third value.
+*/
+type Triple[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+/*
+Zip pairs up the elements of two slices by index. If the slices differ in length,
+the result is truncated to the shorter one.
+
+Parameters:
+  - a: The slice providing the first value of each pair.
+  - b: The slice providing the second value of each pair.
+
+Returns:
+  - A new slice of pairs.
+*/
+func Zip[A, B any](a []A, b []B) []Pair[A, B] {
+	return ZipWith(func(x A, y B) Pair[A, B] { return Pair[A, B]{First: x, Second: y} }, a, b)
+}
+
+/*
+Zip3 groups the elements of three slices by index. If the slices differ in length,
+the result is truncated to the shortest one.
+
+Parameters:
+  - a: The slice providing the first value of each triple.
+  - b: The slice providing the second value of each triple.
+  - c: The slice providing the third value of each triple.
+
+Returns:
+  - A new slice of triples.
+*/
+func Zip3[A, B, C any](a []A, b []B, c []C) []Triple[A, B, C] {
+	result := make([]Triple[A, B, C], min(len(a), len(b), len(c)))
+
+	for i := range result {
+		result[i] = Triple[A, B, C]{First: a[i], Second: b[i], Third: c[i]}
+	}
+
+	return result
+}
+
+/*
+ZipWith combines the elements of two slices by index with the specified function.
+If the slices differ in length, the result is truncated to the shorter one.
+
+Parameters:
+  - combine: A function that takes an element of each slice and returns their
+    combination.
+  - a: The first slice.
+  - b: The second slice.
+
+Returns:
+  - A new slice of combined values.
+*/
+func ZipWith[A, B, R any](combine func(a A, b B) R, a []A, b []B) []R {
+	result := make([]R, min(len(a), len(b)))
+
+	for i := range result {
+		result[i] = combine(a[i], b[i])
+	}
+
+	return result
+}
+
+/*
+Unzip splits a slice of pairs into a slice of first values and a slice of second
+values. It is the inverse of Zip.
+
+Parameters:
+  - pairs: The pairs to split.
+
+Returns:
+  - A new slice with the first values.
+  - A new slice with the second values.
+*/
+func Unzip[A, B any](pairs []Pair[A, B]) ([]A, []B) {
+	a := make([]A, len(pairs))
+	b := make([]B, len(pairs))
+
+	for i, p := range pairs {
+		a[i], b[i] = p.First, p.Second
+	}
+
+	return a, b
+}
+
+/*
+Pairwise returns every pair of adjacent elements of the provided slice.
+
+Parameters:
+  - slice: The slice to pair up.
+
+Returns:
+  - A new slice of len(slice)-1 pairs, where pair i holds elements i and i+1, or an
+    empty slice if the input has fewer than two elements.
+*/
+func Pairwise[V any](slice []V) []Pair[V, V] {
+	result := make([]Pair[V, V], max(len(slice)-1, 0))
+
+	for i := range result {
+		result[i] = Pair[V, V]{First: slice[i], Second: slice[i+1]}
+	}
+
+	return result
+}